@@ -22,11 +22,18 @@ resource "betteruptime_monitor" "this" {
   monitor_group_id = betteruptime_monitor_group.this.id
 }
 
-resource "betteruptime_status_page_resource" "monitor" {
+resource "betteruptime_status_page_section" "monitors" {
   status_page_id = betteruptime_status_page.this.id
-  resource_id    = betteruptime_monitor.this.id
-  resource_type  = "Monitor"
-  public_name    = "example.com site"
+  name           = "Monitors"
+  position       = 0
+}
+
+resource "betteruptime_status_page_resource" "monitor" {
+  status_page_id         = betteruptime_status_page.this.id
+  status_page_section_id = betteruptime_status_page_section.monitors.id
+  resource_id            = betteruptime_monitor.this.id
+  resource_type          = "Monitor"
+  public_name            = "example.com site"
 }
 
 resource "betteruptime_heartbeat_group" "this" {
@@ -1,19 +1,29 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"time"
 
 	"golang.org/x/net/context/ctxhttp"
 )
 
 type client struct {
-	baseURL    string
-	token      string
-	httpClient *http.Client
-	userAgent  string
+	baseURL               string
+	token                 string
+	httpClient            *http.Client
+	userAgent             string
+	maxRetries            int
+	maxServerErrorRetries int
+	retryMaxWait          time.Duration
+	teamName              string
 }
 
 type option func(c *client)
@@ -30,6 +40,25 @@ func withUserAgent(userAgent string) option {
 	}
 }
 
+func withRetry(maxRetries int, retryMaxWait time.Duration) option {
+	return func(c *client) {
+		c.maxRetries = maxRetries
+		c.retryMaxWait = retryMaxWait
+	}
+}
+
+func withServerErrorRetry(maxServerErrorRetries int) option {
+	return func(c *client) {
+		c.maxServerErrorRetries = maxServerErrorRetries
+	}
+}
+
+func withTeamName(teamName string) option {
+	return func(c *client) {
+		c.teamName = teamName
+	}
+}
+
 func newClient(baseURL, token string, opts ...option) (*client, error) {
 	c := client{
 		baseURL:    baseURL,
@@ -59,16 +88,80 @@ func (c *client) Delete(ctx context.Context, path string) (*http.Response, error
 }
 
 func (c *client) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
-	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.baseURL, path), body)
-	if err != nil {
-		return nil, err
+	// Buffer the body so that it can be re-sent if the request has to be retried.
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = ioutil.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		var body io.Reader
+		if reqBody != nil {
+			body = bytes.NewReader(reqBody)
+		}
+		req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.baseURL, path), body)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+		if method == http.MethodPost || method == http.MethodPatch {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		res, err := ctxhttp.Do(ctx, c.httpClient, req)
+		if err != nil {
+			return res, err
+		}
+		var maxRetries int
+		switch {
+		case res.StatusCode == http.StatusTooManyRequests:
+			maxRetries = c.maxRetries
+		case isTransientServerError(res.StatusCode) && method != http.MethodPost:
+			// POST isn't idempotent, so retrying it might create the same resource twice.
+			maxRetries = c.maxServerErrorRetries
+		}
+		if attempt >= maxRetries {
+			return res, err
+		}
+		wait := c.retryWait(res, attempt)
+		// Keep-Alive.
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		_ = res.Body.Close()
+		log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", method, path, res.StatusCode, wait, attempt+1, maxRetries)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func isTransientServerError(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// retryWait honors the Retry-After header (in seconds) and falls back to jittered exponential backoff, capped at retryMaxWait.
+func (c *client) retryWait(res *http.Response, attempt int) time.Duration {
+	// 2^30 seconds is way past any sensible retryMaxWait; shifting further overflows.
+	if attempt > 30 {
+		attempt = 30
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
-	if c.userAgent != "" {
-		req.Header.Set("User-Agent", c.userAgent)
+	wait := time.Second << uint(attempt)
+	// Spread retries of concurrent requests over [wait/2, wait].
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	if v, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && v >= 0 {
+		wait = time.Duration(v) * time.Second
 	}
-	if method == http.MethodPost || method == http.MethodPatch {
-		req.Header.Set("Content-Type", "application/json")
+	if wait > c.retryMaxWait {
+		wait = c.retryMaxWait
 	}
-	return ctxhttp.Do(ctx, c.httpClient, req)
+	return wait
 }
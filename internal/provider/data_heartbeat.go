@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newHeartbeatDataSource() *schema.Resource {
+	s := make(map[string]*schema.Schema)
+	for k, v := range heartbeatSchema {
+		cp := *v
+		switch k {
+		case "name":
+			// keep required
+		default:
+			cp.Computed = true
+			cp.Optional = false
+			cp.Required = false
+			cp.ValidateFunc = nil
+			cp.ValidateDiagFunc = nil
+			cp.Default = nil
+			cp.DefaultFunc = nil
+			cp.DiffSuppressFunc = nil
+		}
+		s[k] = &cp
+	}
+	return &schema.Resource{
+		ReadContext: heartbeatLookup,
+		Description: "Heartbeat lookup.",
+		Schema:      s,
+	}
+}
+
+func heartbeatLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var match heartbeat
+	ids, err := fetchOne(ctx, meta, "/api/v2/heartbeats?page=1", "heartbeat", "heartbeats", fmt.Sprintf("named %q", name), func(attributes json.RawMessage) (bool, error) {
+		var in heartbeat
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Name == nil || *in.Name != name {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(ids[0])
+	return heartbeatCopyAttrs(d, &match)
+}
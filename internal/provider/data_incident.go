@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var incidentStatuses = []string{"started", "acknowledged", "resolved"}
+var incidentSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Incident.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"monitor_id": {
+		Description: "The ID of the monitor to look up the most recent incident of.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"status": {
+		Description:  fmt.Sprintf("The status of the incident. When set, only incidents with this status are considered. Valid values: %v.", incidentStatuses),
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(incidentStatuses, true),
+	},
+	"name": {
+		Description: "The name of the incident.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"cause": {
+		Description: "What caused the incident.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"started_at": {
+		Description: "When the incident started (RFC 3339).",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"resolved_at": {
+		Description: "When the incident was resolved (RFC 3339). Empty if the incident is still ongoing.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newIncidentDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: incidentLookup,
+		Description: "Most recent Incident of a Monitor lookup (see `betteruptime_monitor.last_incident_id`).",
+		Schema:      incidentSchema,
+	}
+}
+
+type incident struct {
+	Name       *string `json:"name,omitempty"`
+	Cause      *string `json:"cause,omitempty"`
+	StartedAt  *string `json:"started_at,omitempty"`
+	ResolvedAt *string `json:"resolved_at,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+func incidentRef(in *incident) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "cause", v: &in.Cause},
+		{k: "started_at", v: &in.StartedAt},
+		{k: "resolved_at", v: &in.ResolvedAt},
+		{k: "status", v: &in.Status},
+	}
+}
+
+func incidentLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	monitorID := d.Get("monitor_id").(string)
+	status := d.Get("status").(string)
+	var id string
+	var match incident
+	var matchStartedAt time.Time
+	if err := fetchAll(ctx, meta, fmt.Sprintf("/api/v2/incidents?monitor_id=%s&page=1", url.QueryEscape(monitorID)), func(eid string, attributes json.RawMessage) error {
+		var in incident
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return err
+		}
+		if status != "" && (in.Status == nil || !strings.EqualFold(*in.Status, status)) {
+			return nil
+		}
+		if in.StartedAt == nil {
+			return nil
+		}
+		// Timestamps may have fractional seconds or a UTC offset, so compare them as times.
+		startedAt, err := time.Parse(time.RFC3339, *in.StartedAt)
+		if err != nil {
+			return fmt.Errorf("incident %s has an invalid started_at: %v", eid, err)
+		}
+		if id == "" || startedAt.After(matchStartedAt) {
+			id = eid
+			match = in
+			matchStartedAt = startedAt
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if id == "" {
+		if status != "" {
+			return diag.Errorf("no %s incident found for monitor %s", status, monitorID)
+		}
+		return diag.Errorf("no incident found for monitor %s", monitorID)
+	}
+	d.SetId(id)
+	var derr diag.Diagnostics
+	for _, e := range incidentRef(&match) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataIncident(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/incidents"
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?monitor_id=1&page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"10","attributes":{"name":"example.com","cause":"Status 500","started_at":"2021-01-01T00:00:00Z","resolved_at":"2021-01-01T01:00:00Z","status":"Resolved"}}],"pagination":{"next":"https://betteruptime.com/api/v2/incidents?monitor_id=1&page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?monitor_id=1&page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"12","attributes":{"name":"example.com","cause":"Timeout","started_at":"2021-03-01T00:00:00Z","status":"Started"}},{"id":"11","attributes":{"name":"example.com","cause":"Status 502","started_at":"2021-02-01T00:00:00Z","resolved_at":"2021-02-01T00:10:00Z","status":"Resolved"}}],"pagination":{"next":null}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?monitor_id=2&page=1":
+			_, _ = w.Write([]byte(`{"data":[],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(monitorID, status string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_incident" "this" {
+			monitor_id = "%s"
+			%s
+		}
+		`, monitorID, status)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "id", "12"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "cause", "Timeout"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "started_at", "2021-03-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "resolved_at", ""),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "status", "Started"),
+				),
+			},
+			{
+				Config: config("1", `status = "resolved"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "id", "11"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "name", "example.com"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "cause", "Status 502"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "resolved_at", "2021-02-01T00:10:00Z"),
+				),
+			},
+			{
+				Config:      config("2", ""),
+				ExpectError: regexp.MustCompile(`no incident found for monitor 2`),
+			},
+		},
+	})
+}
+
+func TestDataIncidentStartedAt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == "/api/v2/incidents?monitor_id=1&page=1":
+			// 2021-03-01T00:30:00+01:00 sorts after 2021-03-01T00:00:00.000Z as a string, but is half an hour earlier.
+			_, _ = w.Write([]byte(`{"data":[{"id":"10","attributes":{"started_at":"2021-02-28T23:45:00.500Z"}},{"id":"11","attributes":{"started_at":"2021-03-01T00:00:00.000Z"}},{"id":"12","attributes":{"started_at":"2021-03-01T00:30:00+01:00"}}],"pagination":{"next":null}}`))
+		case r.Method == http.MethodGet && r.RequestURI == "/api/v2/incidents?monitor_id=2&page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"20","attributes":{"started_at":"yesterday"}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(monitorID string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_incident" "this" {
+			monitor_id = "%s"
+		}
+		`, monitorID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "id", "11"),
+					resource.TestCheckResourceAttr("data.betteruptime_incident.this", "started_at", "2021-03-01T00:00:00.000Z"),
+				),
+			},
+			{
+				Config:      config("2"),
+				ExpectError: regexp.MustCompile(`incident 20 has an invalid started_at`),
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var metadataSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of the account.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"account_name": {
+		Description: "The name of the account.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"plan": {
+		Description: "The plan the account is subscribed to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"used_monitors": {
+		Description: "How many monitors the account uses.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	},
+	"max_monitors": {
+		Description: "How many monitors the plan allows.",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	},
+	"trial_end_at": {
+		Description: "When the trial ends (RFC 3339). Empty if the account isn't on a trial.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newMetadataDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: metadataRead,
+		Description: "Account metadata.",
+		Schema:      metadataSchema,
+	}
+}
+
+type metadata struct {
+	AccountName  *string `json:"account_name,omitempty"`
+	Plan         *string `json:"plan,omitempty"`
+	UsedMonitors *int    `json:"used_monitors,omitempty"`
+	MaxMonitors  *int    `json:"max_monitors,omitempty"`
+	TrialEndAt   *string `json:"trial_end_at,omitempty"`
+}
+
+type metadataHTTPResponse struct {
+	Data struct {
+		ID         string   `json:"id"`
+		Attributes metadata `json:"attributes"`
+	} `json:"data"`
+}
+
+func metadataRef(in *metadata) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "account_name", v: &in.AccountName},
+		{k: "plan", v: &in.Plan},
+		{k: "used_monitors", v: &in.UsedMonitors},
+		{k: "max_monitors", v: &in.MaxMonitors},
+		{k: "trial_end_at", v: &in.TrialEndAt},
+	}
+}
+
+func metadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out metadataHTTPResponse
+	if err, ok := resourceRead(ctx, meta, "/api/v2/metadata", &out); err != nil {
+		return err
+	} else if !ok {
+		return diag.Errorf("account metadata not found")
+	}
+	d.SetId(out.Data.ID)
+	var derr diag.Diagnostics
+	for _, e := range metadataRef(&out.Data.Attributes) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
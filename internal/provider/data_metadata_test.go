@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == "/api/v2/metadata":
+			_, _ = w.Write([]byte(`{"data":{"id":"7","attributes":{"account_name":"Example","plan":"team","used_monitors":12,"max_monitors":50,"trial_end_at":null}}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_metadata" "this" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "id", "7"),
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "account_name", "Example"),
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "plan", "team"),
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "used_monitors", "12"),
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "max_monitors", "50"),
+					resource.TestCheckResourceAttr("data.betteruptime_metadata.this", "trial_end_at", ""),
+				),
+			},
+		},
+	})
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,12 +15,23 @@ func newMonitorDataSource() *schema.Resource {
 	for k, v := range monitorSchema {
 		cp := *v
 		switch k {
-		case "url":
-			// keep required
+		case "auth_scheme":
+			// Only used to validate the configuration.
+			continue
+		case "id", "url", "pronounceable_name":
+			// Lookup keys.
+			cp.Computed = true
+			cp.Optional = true
+			cp.Required = false
+			cp.ValidateFunc = nil
+			cp.ValidateDiagFunc = nil
+			cp.DiffSuppressFunc = nil
+			cp.ExactlyOneOf = []string{"id", "url", "pronounceable_name"}
 		default:
 			cp.Computed = true
 			cp.Optional = false
 			cp.Required = false
+			cp.ValidateFunc = nil
 			cp.ValidateDiagFunc = nil
 			cp.Default = nil
 			cp.DefaultFunc = nil
@@ -32,66 +41,46 @@ func newMonitorDataSource() *schema.Resource {
 	}
 	return &schema.Resource{
 		ReadContext: monitorLookup,
-		Description: "Monitor lookup.",
+		Description: "Monitor lookup by id, url or pronounceable_name.",
 		Schema:      s,
 	}
 }
 
-type monitorPageHTTPResponse struct {
-	Data []struct {
-		ID         string  `json:"id"`
-		Attributes monitor `json:"attributes"`
-	} `json:"data"`
-	Pagination struct {
-		First string `json:"first"`
-		Last  string `json:"last"`
-		Prev  string `json:"prev"`
-		Next  string `json:"next"`
-	} `json:"pagination"`
-}
-
 func monitorLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	fetch := func(page int) (*monitorPageHTTPResponse, error) {
-		res, err := meta.(*client).Get(ctx, fmt.Sprintf("/api/v2/monitors?page=%d", page))
-		if err != nil {
-			return nil, err
-		}
-		defer func() {
-			// Keep-Alive.
-			_, _ = io.Copy(ioutil.Discard, res.Body)
-			_ = res.Body.Close()
-		}()
-		body, err := ioutil.ReadAll(res.Body)
-		if res.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("GET %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
+	if id := d.Get("id").(string); id != "" {
+		var out monitorHTTPResponse
+		if derr, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/monitors/%s", url.PathEscape(id)), &out); derr != nil {
+			return derr
+		} else if !ok {
+			return diag.Errorf("no monitor with id %s found", id)
 		}
-		if err != nil {
-			return nil, err
-		}
-		var tr monitorPageHTTPResponse
-		return &tr, json.Unmarshal(body, &tr)
+		d.SetId(out.Data.ID)
+		return monitorCopyAttrs(d, &out.Data.Attributes)
+	}
+	key := "url"
+	if _, ok := d.GetOk("pronounceable_name"); ok {
+		key = "pronounceable_name"
 	}
-	url := d.Get("url").(string)
-	page := 1
-	for {
-		res, err := fetch(page)
-		if err != nil {
-			return diag.FromErr(err)
+	value := d.Get(key).(string)
+	var match monitor
+	ids, err := fetchOne(ctx, meta, "/api/v2/monitors?page=1", "monitor", "monitors", fmt.Sprintf("with %s %q", key, value), func(attributes json.RawMessage) (bool, error) {
+		var in monitor
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
 		}
-		for _, e := range res.Data {
-			if *e.Attributes.URL == url {
-				if d.Id() != "" {
-					return diag.Errorf("duplicate")
-				}
-				d.SetId(e.ID)
-				if derr := monitorCopyAttrs(d, &e.Attributes); derr != nil {
-					return derr
-				}
-			}
+		v := in.URL
+		if key == "pronounceable_name" {
+			v = in.PronounceableName
 		}
-		page++
-		if res.Pagination.Next == "" {
-			return nil
+		if v == nil || *v != value {
+			return false, nil
 		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
 	}
+	d.SetId(ids[0])
+	return monitorCopyAttrs(d, &match)
 }
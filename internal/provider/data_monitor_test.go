@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,9 +24,13 @@ func TestDataMonitor(t *testing.T) {
 
 		switch {
 		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
-			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"url":"http://example.net","monitor_type":"status"}}],"pagination":{"next":"..."}}`))
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"url":"http://example.net","monitor_type":"status","pronounceable_name":"Example"}},{"id":"3","attributes":{"url":"http://example.org","monitor_type":"ping","pronounceable_name":"Duplicate"}}],"pagination":{"next":"https://betteruptime.com/api/v2/monitors?page=2"}}`))
 		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
-			_, _ = w.Write([]byte(`{"data":[{"id":"2","attributes":{"url":"http://example.com","monitor_type":"status"}}],"pagination":{"next":null}}`))
+			_, _ = w.Write([]byte(`{"data":[{"id":"2","attributes":{"url":"http://example.com","monitor_type":"status"}},{"id":"4","attributes":{"url":"http://example.io","monitor_type":"ping","pronounceable_name":"Duplicate"}}],"pagination":{"next":null}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"/2":
+			_, _ = w.Write([]byte(`{"data":{"id":"2","attributes":{"url":"http://example.com","monitor_type":"status"}}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"/5":
+			w.WriteHeader(http.StatusNotFound)
 		default:
 			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
 		}
@@ -58,8 +63,72 @@ func TestDataMonitor(t *testing.T) {
 					resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "monitor_type", "status"),
 				),
 			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitor" "this" {
+					id = "2"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "id", "2"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "url", url),
+				),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitor" "this" {
+					pronounceable_name = "Example"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "id", "1"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "url", "http://example.net"),
+				),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitor" "this" {
+					id = "5"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`no monitor with id 5 found`),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitor" "this" {
+					url = "http://missing.example.com"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`no monitor with url "http://missing.example.com" found`),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitor" "this" {
+					pronounceable_name = "Duplicate"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`found 2 monitors with pronounceable_name "Duplicate" \(IDs: 3, 4\)`),
+			},
 		},
 	})
 }
-
-// TODO: test duplicate
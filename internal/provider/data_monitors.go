@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newMonitorsDataSource() *schema.Resource {
+	monitorElem := make(map[string]*schema.Schema)
+	for k, v := range newMonitorDataSource().Schema {
+		// Sensitive attributes (e.g. auth_password) stay sensitive within the nested block.
+		cp := *v
+		cp.Computed = true
+		cp.Optional = false
+		cp.ExactlyOneOf = nil
+		monitorElem[k] = &cp
+	}
+	return &schema.Resource{
+		ReadContext: monitorsLookup,
+		Description: "Monitors lookup (optionally filtered by paused, monitor_type or team_name).",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "A hash of the IDs of the monitors found.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"paused": {
+				Description: "Only include monitors that are (or aren't) paused.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"monitor_type": {
+				Description: "Only include monitors of this type.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"team_name": {
+				Description: "Only include monitors of this team. Monitors that don't report their team are left out.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"monitors": {
+				Description: "The monitors found.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: monitorElem,
+				},
+			},
+		},
+	}
+}
+
+func monitorsLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	paused, filterPaused := d.GetOkExists("paused")
+	monitorType := d.Get("monitor_type").(string)
+	teamName := d.Get("team_name").(string)
+	var ids []string
+	monitors := []interface{}{}
+	if err := fetchAll(ctx, meta, "/api/v2/monitors?page=1", func(id string, attributes json.RawMessage) error {
+		var in monitor
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return err
+		}
+		if filterPaused && (in.Paused != nil && *in.Paused) != paused.(bool) {
+			return nil
+		}
+		if monitorType != "" && (in.MonitorType == nil || *in.MonitorType != monitorType) {
+			return nil
+		}
+		if teamName != "" && (in.TeamName == nil || !strings.EqualFold(*in.TeamName, teamName)) {
+			return nil
+		}
+		ids = append(ids, id)
+		monitors = append(monitors, monitorFlatten(id, &in))
+		return nil
+	}); err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	if err := d.Set("monitors", monitors); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// monitorFlatten converts a monitor into a map suitable for a nested "monitors" block.
+func monitorFlatten(id string, in *monitor) map[string]interface{} {
+	m := map[string]interface{}{"id": id}
+	monitorNormalize(in)
+	for _, e := range monitorRef(in) {
+		if v := reflect.Indirect(reflect.ValueOf(e.v)); !v.IsNil() {
+			m[e.k] = v.Elem().Interface()
+		}
+	}
+	headers := map[string]interface{}{}
+	if in.RequestHeaders != nil {
+		for _, h := range *in.RequestHeaders {
+			headers[h.Name] = h.Value
+		}
+	}
+	m["request_headers"] = headers
+	m["step"] = monitorFlattenSteps(in)
+	return m
+}
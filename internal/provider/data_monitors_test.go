@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataMonitors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/monitors"
+
+		// With a global token, Better Uptime returns the monitors of every team.
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"url":"http://example.net","monitor_type":"status","pronounceable_name":"Example","paused":true,"team_name":"Platform","auth_username":"user","auth_password":"secret"}},{"id":"3","attributes":{"url":"http://example.org","monitor_type":"ping","pronounceable_name":"Duplicate"}}],"pagination":{"next":"https://betteruptime.com/api/v2/monitors?page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"2","attributes":{"url":"http://example.com","monitor_type":"status"}},{"id":"4","attributes":{"url":"http://example.io","monitor_type":"ping","pronounceable_name":"Duplicate"}},{"id":"5","attributes":{"url":"http://example.dev","monitor_type":"status","paused":true,"team_name":"platform"}},{"id":"6","attributes":{"url":"http://example.app","monitor_type":"status","paused":true,"team_name":"Infrastructure"}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitors" "this" {
+					
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.#", "6"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.id", "1"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.pronounceable_name", "Example"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.3.url", "http://example.io"),
+				),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitors" "this" {
+					monitor_type = "ping"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.#", "2"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.id", "3"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.1.id", "4"),
+				),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitors" "this" {
+					paused    = true
+					team_name = "platform"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.#", "2"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.id", "1"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.paused", "true"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.auth_username", "user"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.0.auth_password", "secret"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.1.id", "5"),
+					// Defaults for attributes older monitors don't report, as in betteruptime_monitor.
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.1.follow_redirects", "true"),
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.1.create_incident", "true"),
+				),
+			},
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				data "betteruptime_monitors" "this" {
+					paused = false
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_monitors.this", "monitors.#", "3"),
+				),
+			},
+		},
+	})
+}
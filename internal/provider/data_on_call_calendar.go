@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newOnCallCalendarDataSource() *schema.Resource {
+	computed := func(in map[string]*schema.Schema) map[string]*schema.Schema {
+		s := make(map[string]*schema.Schema)
+		for k, v := range in {
+			cp := *v
+			cp.Computed = true
+			cp.Optional = false
+			cp.Required = false
+			cp.ValidateFunc = nil
+			cp.ValidateDiagFunc = nil
+			cp.Default = nil
+			cp.DefaultFunc = nil
+			cp.DiffSuppressFunc = nil
+			if elem, ok := cp.Elem.(*schema.Schema); ok {
+				e := *elem
+				e.ValidateFunc = nil
+				cp.Elem = &e
+			}
+			s[k] = &cp
+		}
+		return s
+	}
+	s := computed(onCallCalendarSchema)
+	s["name"] = onCallCalendarSchema["name"]
+	member := computed(onCallCalendarMemberSchema)
+	member["override"].Elem = &schema.Resource{
+		Schema: computed(onCallCalendarMemberOverrideSchema),
+	}
+	s["member"].Elem = &schema.Resource{
+		Schema: member,
+	}
+	return &schema.Resource{
+		ReadContext: onCallCalendarLookup,
+		Description: "On-call Calendar lookup.",
+		Schema:      s,
+	}
+}
+
+func onCallCalendarLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var match onCallCalendar
+	ids, err := fetchOne(ctx, meta, "/api/v2/on-call-calendars?page=1", "on-call calendar", "on-call calendars", fmt.Sprintf("named %q", name), func(attributes json.RawMessage) (bool, error) {
+		var in onCallCalendar
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Name == nil || *in.Name != name {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(ids[0])
+	_, members, err := onCallCalendarFetchMembers(ctx, meta, ids[0])
+	if err != nil {
+		return err
+	}
+	return onCallCalendarCopyAttrs(d, &match, members)
+}
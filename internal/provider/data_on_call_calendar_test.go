@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataOnCallCalendar(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/on-call-calendars"
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"name":"Ops","time_zone":"UTC"}},{"id":"2","attributes":{"name":"duplicate"}}],"pagination":{"next":"https://betteruptime.com/api/v2/on-call-calendars?page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"3","attributes":{"name":"Platform","time_zone":"Amsterdam"}},{"id":"4","attributes":{"name":"duplicate"}}],"pagination":{"next":null}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"/3/members?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"7","attributes":{"team_member_id":10,"position":0,"weekdays":[1,2,3,4,5],"from_hour":9,"to_hour":17,"overrides":[{"from":"2021-12-24T01:00:00.000+01:00","to":"2021-12-27T00:00:00.000Z"}]}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_on_call_calendar" "this" {
+			name = "%s"
+		}
+		`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "id", "3"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "name", "Platform"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "time_zone", "Amsterdam"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.#", "1"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.0.team_member_id", "10"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.0.weekdays.#", "5"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.0.to_hour", "17"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.0.override.0.from", "2021-12-24T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.betteruptime_on_call_calendar.this", "member.0.override.0.to", "2021-12-27T00:00:00Z"),
+				),
+			},
+			{
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile(`no on-call calendar named "missing" found`),
+			},
+			{
+				Config:      config("duplicate"),
+				ExpectError: regexp.MustCompile(`found 2 on-call calendars named "duplicate" \(IDs: 2, 4\)`),
+			},
+		},
+	})
+}
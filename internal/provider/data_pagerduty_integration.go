@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newPagerdutyIntegrationDataSource() *schema.Resource {
+	s := make(map[string]*schema.Schema)
+	for k, v := range pagerdutyIntegrationSchema {
+		cp := *v
+		switch k {
+		case "name":
+			// keep required
+		default:
+			// pagerduty_service_key stays sensitive.
+			cp.Computed = true
+			cp.Optional = false
+			cp.Required = false
+			cp.ValidateFunc = nil
+			cp.ValidateDiagFunc = nil
+			cp.Default = nil
+			cp.DefaultFunc = nil
+			cp.DiffSuppressFunc = nil
+		}
+		s[k] = &cp
+	}
+	return &schema.Resource{
+		ReadContext: pagerdutyIntegrationLookup,
+		Description: "PagerDuty Integration lookup.",
+		Schema:      s,
+	}
+}
+
+func pagerdutyIntegrationLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var match pagerdutyIntegration
+	ids, err := fetchOne(ctx, meta, "/api/v2/pager-duty-webhooks?page=1", "PagerDuty integration", "PagerDuty integrations", fmt.Sprintf("named %q", name), func(attributes json.RawMessage) (bool, error) {
+		var in pagerdutyIntegration
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Name == nil || *in.Name != name {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(ids[0])
+	return pagerdutyIntegrationCopyAttrs(d, &match)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var slackIntegrationSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Slack Integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the Slack integration that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"webhook_url": {
+		Description: "The Slack incoming webhook URL used by this integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+	},
+	"channel": {
+		Description: "The Slack channel notifications are sent to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"team_name": {
+		Description: "The name of the team this integration belongs to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newSlackIntegrationDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: slackIntegrationLookup,
+		Description: "Slack Integration lookup.",
+		Schema:      slackIntegrationSchema,
+	}
+}
+
+type slackIntegration struct {
+	Name       *string `json:"name,omitempty"`
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	Channel    *string `json:"channel,omitempty"`
+	TeamName   *string `json:"team_name,omitempty"`
+}
+
+func slackIntegrationRef(in *slackIntegration) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "webhook_url", v: &in.WebhookURL},
+		{k: "channel", v: &in.Channel},
+		{k: "team_name", v: &in.TeamName},
+	}
+}
+
+func slackIntegrationLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var match slackIntegration
+	ids, err := fetchOne(ctx, meta, "/api/v2/slack-integrations?page=1", "Slack integration", "Slack integrations", fmt.Sprintf("named %q", name), func(attributes json.RawMessage) (bool, error) {
+		var in slackIntegration
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Name == nil || *in.Name != name {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(ids[0])
+	var derr diag.Diagnostics
+	for _, e := range slackIntegrationRef(&match) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSlackIntegration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/slack-integrations"
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"name":"ops","channel":"#ops"}},{"id":"2","attributes":{"name":"duplicate"}}],"pagination":{"next":"https://betteruptime.com/api/v2/slack-integrations?page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"3","attributes":{"name":"alerts","webhook_url":"https://hooks.slack.com/services/example","channel":"#alerts","team_name":"Example"}},{"id":"4","attributes":{"name":"duplicate"}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_slack_integration" "this" {
+			name = "%s"
+		}
+		`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("alerts"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_slack_integration.this", "id", "3"),
+					resource.TestCheckResourceAttr("data.betteruptime_slack_integration.this", "name", "alerts"),
+					resource.TestCheckResourceAttr("data.betteruptime_slack_integration.this", "webhook_url", "https://hooks.slack.com/services/example"),
+					resource.TestCheckResourceAttr("data.betteruptime_slack_integration.this", "channel", "#alerts"),
+					resource.TestCheckResourceAttr("data.betteruptime_slack_integration.this", "team_name", "Example"),
+				),
+			},
+			{
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile(`no Slack integration named "missing" found`),
+			},
+			{
+				Config:      config("duplicate"),
+				ExpectError: regexp.MustCompile(`found 2 Slack integrations named "duplicate" \(IDs: 2, 4\)`),
+			},
+		},
+	})
+}
+
+func TestDataSlackIntegrationSensitive(t *testing.T) {
+	if !New().DataSourcesMap["betteruptime_slack_integration"].Schema["webhook_url"].Sensitive {
+		t.Error("webhook_url must be sensitive")
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var teamSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Team.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the team that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"on_call_source": {
+		Description: "Where the team's on-call schedule comes from.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newTeamDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: teamLookup,
+		Description: "Team lookup.",
+		Schema:      teamSchema,
+	}
+}
+
+type team struct {
+	Name         *string `json:"name,omitempty"`
+	OnCallSource *string `json:"on_call_source,omitempty"`
+}
+
+func teamRef(in *team) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "on_call_source", v: &in.OnCallSource},
+	}
+}
+
+func teamLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var match team
+	ids, err := fetchOne(ctx, meta, "/api/v2/teams?page=1", "team", "teams", fmt.Sprintf("named %q", name), func(attributes json.RawMessage) (bool, error) {
+		var in team
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Name == nil || *in.Name != name {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(ids[0])
+	var derr diag.Diagnostics
+	for _, e := range teamRef(&match) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
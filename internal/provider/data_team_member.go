@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var teamMemberSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Team Member.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"email": {
+		Description: "The email address of the team member (case-insensitive).",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"team_name": {
+		Description: "The name of the team the member belongs to. Needed when the same email address belongs to members of several teams (when using global tokens).",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+	},
+	"first_name": {
+		Description: "The first name of the team member.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"last_name": {
+		Description: "The last name of the team member.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"role": {
+		Description: "The role of the team member within the team.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"on_call": {
+		Description: "Whether the team member is currently on-call.",
+		Type:        schema.TypeBool,
+		Computed:    true,
+	},
+}
+
+func newTeamMemberDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: teamMemberLookup,
+		Description: "Team Member lookup.",
+		Schema:      teamMemberSchema,
+	}
+}
+
+type teamMember struct {
+	Email     *string `json:"email,omitempty"`
+	TeamName  *string `json:"team_name,omitempty"`
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Role      *string `json:"role,omitempty"`
+	OnCall    *bool   `json:"on_call,omitempty"`
+}
+
+func teamMemberRef(in *teamMember) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "email", v: &in.Email},
+		{k: "team_name", v: &in.TeamName},
+		{k: "first_name", v: &in.FirstName},
+		{k: "last_name", v: &in.LastName},
+		{k: "role", v: &in.Role},
+		{k: "on_call", v: &in.OnCall},
+	}
+}
+
+func teamMemberLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	email := d.Get("email").(string)
+	teamName := d.Get("team_name").(string)
+	by := fmt.Sprintf("with email %q", email)
+	if teamName != "" {
+		by += fmt.Sprintf(" in team %q", teamName)
+	}
+	var match teamMember
+	ids, err := fetchOne(ctx, meta, "/api/v2/team-members?page=1", "team member", "team members", by, func(attributes json.RawMessage) (bool, error) {
+		var in teamMember
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return false, err
+		}
+		if in.Email == nil || !strings.EqualFold(*in.Email, email) {
+			return false, nil
+		}
+		if teamName != "" && (in.TeamName == nil || *in.TeamName != teamName) {
+			return false, nil
+		}
+		match = in
+		return true, nil
+	})
+	if err != nil {
+		if len(ids) > 1 && teamName == "" {
+			err[0].Detail = "The email address belongs to members of several teams, set team_name to pick one."
+		}
+		return err
+	}
+	d.SetId(ids[0])
+	var derr diag.Diagnostics
+	for _, e := range teamMemberRef(&match) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataTeamMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/team-members"
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"email":"jane@example.com","team_name":"Ops","first_name":"Jane","last_name":"Doe","role":"admin","on_call":true}},{"id":"2","attributes":{"email":"john@example.com","team_name":"Ops"}}],"pagination":{"next":"https://betteruptime.com/api/v2/team-members?page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"3","attributes":{"email":"john@example.com","team_name":"Platform","first_name":"John","last_name":"Smith","role":"responder","on_call":false}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(attrs string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_team_member" "this" {
+			%s
+		}
+		`, attrs)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(`email = "Jane@Example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "id", "1"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "team_name", "Ops"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "first_name", "Jane"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "last_name", "Doe"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "role", "admin"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "on_call", "true"),
+				),
+			},
+			{
+				Config: config(`
+				email     = "john@example.com"
+				team_name = "Platform"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "id", "3"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "first_name", "John"),
+					resource.TestCheckResourceAttr("data.betteruptime_team_member.this", "on_call", "false"),
+				),
+			},
+			{
+				Config:      config(`email = "missing@example.com"`),
+				ExpectError: regexp.MustCompile(`no team member with email "missing@example.com" found`),
+			},
+			{
+				Config: config(`
+				email     = "jane@example.com"
+				team_name = "Platform"
+				`),
+				ExpectError: regexp.MustCompile(`no team member with email "jane@example.com" in team "Platform" found`),
+			},
+			{
+				Config:      config(`email = "john@example.com"`),
+				ExpectError: regexp.MustCompile(`(?s)found 2 team members with email "john@example.com" \(IDs: 2, 3\).*set team_name to\s+pick\s+one`),
+			},
+		},
+	})
+}
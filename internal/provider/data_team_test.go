@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataTeam(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Header.Get("Authorization") != "Bearer foo" {
+			t.Fatal("Not authorized: " + r.Header.Get("Authorization"))
+		}
+
+		prefix := "/api/v2/teams"
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"name":"Ops","on_call_source":"calendar"}},{"id":"2","attributes":{"name":"duplicate"}}],"pagination":{"next":"https://betteruptime.com/api/v2/teams?page=2"}}`))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=2":
+			_, _ = w.Write([]byte(`{"data":[{"id":"3","attributes":{"name":"Platform","on_call_source":"pagerduty"}},{"id":"4","attributes":{"name":"duplicate"}}],"pagination":{"next":null}}`))
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	}))
+	defer server.Close()
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		data "betteruptime_team" "this" {
+			name = "%s"
+		}
+		`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.betteruptime_team.this", "id", "3"),
+					resource.TestCheckResourceAttr("data.betteruptime_team.this", "name", "Platform"),
+					resource.TestCheckResourceAttr("data.betteruptime_team.this", "on_call_source", "pagerduty"),
+				),
+			},
+			{
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile(`no team named "missing" found`),
+			},
+			{
+				Config:      config("duplicate"),
+				ExpectError: regexp.MustCompile(`found 2 teams named "duplicate" \(IDs: 2, 4\)`),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type provider struct {
@@ -44,17 +45,68 @@ func New(opts ...Option) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("BETTERUPTIME_API_TOKEN", nil),
 				Description: "Better Uptime API Token. The value can be omitted if `BETTERUPTIME_API_TOKEN` environment variable is set. See https://docs.betteruptime.com/api/getting-started#obtaining-an-api-token on how to obtain the API token for your team.",
 			},
+			"team_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default `team_name` for resources that have one (e.g. `betteruptime_monitor`), used to specify the team they should be created in when using global tokens.",
+			},
+			"http_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of seconds to wait for a response from the Better Uptime API before giving up on a request.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "How many times a request rate limited by Better Uptime (HTTP 429) is retried before giving up. At most 100.",
+			},
+			"max_server_error_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "How many times a request failing with a transient server error (HTTP 500, 502 or 503) is retried before giving up. Only idempotent requests (i.e. not creating resources) are retried. At most 100.",
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of seconds to wait before retrying a rate limited or failed request. `Retry-After` is honored up to this limit, otherwise a jittered exponential backoff is used.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"betteruptime_monitor": newMonitorDataSource(),
+			"betteruptime_heartbeat":             newHeartbeatDataSource(),
+			"betteruptime_incident":              newIncidentDataSource(),
+			"betteruptime_metadata":              newMetadataDataSource(),
+			"betteruptime_monitor":               newMonitorDataSource(),
+			"betteruptime_monitors":              newMonitorsDataSource(),
+			"betteruptime_on_call_calendar":      newOnCallCalendarDataSource(),
+			"betteruptime_pagerduty_integration": newPagerdutyIntegrationDataSource(),
+			"betteruptime_slack_integration":     newSlackIntegrationDataSource(),
+			"betteruptime_team":                  newTeamDataSource(),
+			"betteruptime_team_member":           newTeamMemberDataSource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"betteruptime_heartbeat":            newHeartbeatResource(),
-			"betteruptime_heartbeat_group":      newHeartbeatGroupResource(),
-			"betteruptime_monitor":              newMonitorResource(),
-			"betteruptime_monitor_group":        newMonitorGroupResource(),
-			"betteruptime_status_page":          newStatusPageResource(),
-			"betteruptime_status_page_resource": newStatusPageResourceResource(),
+			"betteruptime_email_integration":             newEmailIntegrationResource(),
+			"betteruptime_escalation_policy":             newEscalationPolicyResource(),
+			"betteruptime_heartbeat":                     newHeartbeatResource(),
+			"betteruptime_heartbeat_group":               newHeartbeatGroupResource(),
+			"betteruptime_incoming_webhook":              newIncomingWebhookResource(),
+			"betteruptime_monitor":                       newMonitorResource(),
+			"betteruptime_monitor_group":                 newMonitorGroupResource(),
+			"betteruptime_on_call_calendar":              newOnCallCalendarResource(),
+			"betteruptime_pagerduty_integration":         newPagerdutyIntegrationResource(),
+			"betteruptime_policy":                        newPolicyResource(),
+			"betteruptime_status_page":                   newStatusPageResource(),
+			"betteruptime_status_page_resource":          newStatusPageResourceResource(),
+			"betteruptime_status_page_section":           newStatusPageSectionResource(),
+			"betteruptime_status_page_subscription":      newStatusPageSubscriptionResource(),
+			"betteruptime_team_notification_integration": newTeamNotificationIntegrationResource(),
 		},
 		ConfigureContextFunc: func(ctx context.Context, r *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			var userAgent string
@@ -63,9 +115,12 @@ func New(opts ...Option) *schema.Provider {
 			}
 			c, err := newClient(spec.url, r.Get("api_token").(string),
 				withHTTPClient(&http.Client{
-					Timeout: time.Second * 60,
+					Timeout: time.Duration(r.Get("http_timeout").(int)) * time.Second,
 				}),
-				withUserAgent(userAgent))
+				withUserAgent(userAgent),
+				withRetry(r.Get("max_retries").(int), time.Duration(r.Get("retry_max_wait").(int))*time.Second),
+				withServerErrorRetry(r.Get("max_server_error_retries").(int)),
+				withTeamName(r.Get("team_name").(string)))
 			return c, diag.FromErr(err)
 		},
 	}
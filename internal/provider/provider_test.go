@@ -1,15 +1,39 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"regexp"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// testAccProviderFactories configure a provider that talks to the real Better Uptime API
+// (authenticated via BETTERUPTIME_API_TOKEN), for use in acceptance tests.
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"betteruptime": func() (*schema.Provider, error) {
+		return New(), nil
+	},
+}
+
+// testAccPreCheck skips an acceptance test unless BETTERUPTIME_API_TOKEN is set.
+// resource.Test itself skips acceptance tests unless TF_ACC is set (see `make testacc`).
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("BETTERUPTIME_API_TOKEN") == "" {
+		t.Skip("BETTERUPTIME_API_TOKEN must be set for acceptance tests")
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := New().InternalValidate(); err != nil {
 		t.Fatal(err)
@@ -62,3 +86,297 @@ func TestProviderInit(t *testing.T) {
 		t.Fatalf("HTTP server didn't receive any requests")
 	}
 }
+
+func TestProviderAPITokenEnv(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitor-groups", "1")
+	defer server.Close()
+
+	defer os.Setenv("BETTERUPTIME_API_TOKEN", os.Getenv("BETTERUPTIME_API_TOKEN"))
+	if err := os.Setenv("BETTERUPTIME_API_TOKEN", "foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create (the test server rejects any token other than "foo").
+			{
+				Config: `
+				provider "betteruptime" {}
+
+				resource "betteruptime_monitor_group" "this" {
+					name = "example"
+				}
+				`,
+				Check: resource.TestCheckResourceAttr("betteruptime_monitor_group.this", "id", "1"),
+			},
+		},
+	})
+}
+
+func TestProviderRetry(t *testing.T) {
+	var requests, rateLimited int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		// Rate limit two out of every three requests.
+		if atomic.AddInt32(&requests, 1)%3 != 0 {
+			atomic.AddInt32(&rateLimited, 1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"url":"http://example.com","monitor_type":"status"}}],"pagination":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token      = "foo"
+					max_retries    = 2
+					retry_max_wait = 1
+				}
+				data "betteruptime_monitor" "this" {
+					url = "http://example.com"
+				}
+				`,
+				Check: resource.TestCheckResourceAttr("data.betteruptime_monitor.this", "id", "1"),
+			},
+		},
+	})
+
+	if atomic.LoadInt32(&rateLimited) == 0 {
+		t.Fatalf("HTTP server didn't rate limit any requests")
+	}
+}
+
+func TestProviderHTTPTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		// Respond only after the client is expected to have given up.
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"url":"http://example.com","monitor_type":"status"}}],"pagination":{"next":null}}`))
+	}))
+	defer server.Close()
+	defer close(done)
+
+	start := time.Now()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token    = "foo"
+					http_timeout = 5
+				}
+				data "betteruptime_monitor" "this" {
+					url = "http://example.com"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Client.Timeout exceeded`),
+			},
+		},
+	})
+
+	if elapsed := time.Since(start); elapsed >= 10*time.Second {
+		t.Fatalf("request wasn't cancelled after http_timeout (took %s)", elapsed)
+	}
+}
+
+func TestProviderServerErrorRetry(t *testing.T) {
+	var requests, posts, failed int32
+	handler := newResourceHandler(t, "/api/v2/monitor-groups", "1", nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			// Fail the first POST, which must not be retried.
+			if atomic.AddInt32(&posts, 1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		} else if atomic.AddInt32(&requests, 1)%2 != 0 {
+			// Fail every other idempotent request.
+			atomic.AddInt32(&failed, 1)
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token                = "foo"
+			max_server_error_retries = 1
+			retry_max_wait           = 0
+		}
+
+		resource "betteruptime_monitor_group" "this" {
+			name = "%s"
+		}
+		`, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create fails.
+			{
+				Config:      config("example"),
+				ExpectError: regexp.MustCompile(`POST .* returned 500`),
+			},
+			// Step 2 - create.
+			{
+				Config: config("example"),
+				Check:  resource.TestCheckResourceAttr("betteruptime_monitor_group.this", "name", "example"),
+			},
+			// Step 3 - update.
+			{
+				Config: config("example (renamed)"),
+				Check:  resource.TestCheckResourceAttr("betteruptime_monitor_group.this", "name", "example (renamed)"),
+			},
+		},
+	})
+
+	if n := atomic.LoadInt32(&posts); n != 2 {
+		t.Fatalf("POST: got %d requests, want 2 (no retries)", n)
+	}
+	if atomic.LoadInt32(&failed) == 0 {
+		t.Fatalf("HTTP server didn't fail any requests")
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c, err := newClient("", "foo", withRetry(100, 30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := &http.Response{Header: http.Header{}}
+	for _, attempt := range []int{0, 1, 33, 34, 63, 64, 100} {
+		if wait := c.retryWait(res, attempt); wait <= 0 || wait > 30*time.Second {
+			t.Errorf("retryWait(attempt=%d) = %s, want (0s, 30s]", attempt, wait)
+		}
+	}
+	res.Header.Set("Retry-After", "5")
+	if wait := c.retryWait(res, 100); wait != 5*time.Second {
+		t.Errorf("retryWait(attempt=100) with Retry-After: 5 = %s, want 5s", wait)
+	}
+}
+
+func TestProviderTeamName(t *testing.T) {
+	var mu sync.Mutex
+	teamNames := make(map[string]string)
+	mux := http.NewServeMux()
+	for _, prefix := range []string{"/api/v2/heartbeats", "/api/v2/monitors", "/api/v2/status-pages/0/sections"} {
+		prefix := prefix
+		handler := newResourceHandler(t, prefix, "1", nil)
+		// Better Uptime takes team_name in the request body, but doesn't return it.
+		h := omitAttributes(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var attrs struct {
+					TeamName string `json:"team_name"`
+				}
+				if err := json.Unmarshal(body, &attrs); err != nil {
+					t.Fatal(err)
+				}
+				mu.Lock()
+				teamNames[prefix] = attrs.TeamName
+				mu.Unlock()
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+			handler.ServeHTTP(w, r)
+		}), "team_name")
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+		team_name = "Platform"
+	}
+
+	resource "betteruptime_heartbeat" "this" {
+		name   = "example"
+		period = 30
+		grace  = 0
+	}
+
+	resource "betteruptime_monitor" "this" {
+		team_name    = "Other"
+		url          = "http://example.com"
+		monitor_type = "status"
+	}
+
+	resource "betteruptime_status_page_section" "this" {
+		status_page_id = "0"
+		name           = "example"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+
+	want := map[string]string{
+		"/api/v2/heartbeats":              "Platform", // Provider-level default.
+		"/api/v2/monitors":                "Other",    // Overridden by the resource.
+		"/api/v2/status-pages/0/sections": "",         // Sections don't belong to a team.
+	}
+	if fmt.Sprint(teamNames) != fmt.Sprint(want) {
+		t.Fatalf("team_name: got %v, want %v", teamNames, want)
+	}
+}
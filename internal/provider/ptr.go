@@ -19,6 +19,11 @@ func load(d *schema.ResourceData, key string, receiver interface{}) {
 			t := v.(int)
 			*x = &t
 		}
+	case **float64:
+		if v, ok := d.GetOkExists(key); ok {
+			t := v.(float64)
+			*x = &t
+		}
 	case **bool:
 		if v, ok := d.GetOkExists(key); ok {
 			t := v.(bool)
@@ -26,12 +31,23 @@ func load(d *schema.ResourceData, key string, receiver interface{}) {
 		}
 	case **[]string:
 		if v, ok := d.GetOkExists(key); ok {
+			if set, ok := v.(*schema.Set); ok {
+				v = set.List()
+			}
 			var t []string
 			for _, v := range v.([]interface{}) {
 				t = append(t, v.(string))
 			}
 			*x = &t
 		}
+	case **[]int:
+		if v, ok := d.GetOkExists(key); ok {
+			var t []int
+			for _, v := range v.([]interface{}) {
+				t = append(t, v.(int))
+			}
+			*x = &t
+		}
 	default:
 		panic(fmt.Errorf("unexpected type %T", receiver))
 	}
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
@@ -29,7 +33,7 @@ func resourceCreate(ctx context.Context, meta interface{}, url string, in, out i
 	}()
 	body, err := ioutil.ReadAll(res.Body)
 	if res.StatusCode != http.StatusCreated {
-		return diag.Errorf("POST %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
+		return responseError(res, body)
 	}
 	if err != nil {
 		return diag.FromErr(err)
@@ -41,6 +45,15 @@ func resourceCreate(ctx context.Context, meta interface{}, url string, in, out i
 	return nil
 }
 
+// defaultTeamName falls back to the provider-level team_name when a resource with a team_name attribute doesn't specify one.
+func defaultTeamName(meta interface{}, teamName *string) *string {
+	if teamName == nil && meta.(*client).teamName != "" {
+		t := meta.(*client).teamName
+		return &t
+	}
+	return teamName
+}
+
 func resourceRead(ctx context.Context, meta interface{}, url string, out interface{}) (derr diag.Diagnostics, ok bool) {
 	log.Printf("GET %s", url)
 	res, err := meta.(*client).Get(ctx, url)
@@ -57,7 +70,7 @@ func resourceRead(ctx context.Context, meta interface{}, url string, out interfa
 	}
 	body, err := ioutil.ReadAll(res.Body)
 	if res.StatusCode != http.StatusOK {
-		return diag.Errorf("GET %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body)), false
+		return responseError(res, body), false
 	}
 	if err != nil {
 		return diag.FromErr(err), false
@@ -87,11 +100,90 @@ func resourceUpdate(ctx context.Context, meta interface{}, url string, req inter
 	}()
 	body, _ := ioutil.ReadAll(res.Body)
 	if res.StatusCode != http.StatusOK {
-		return diag.Errorf("PATCH %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
+		return responseError(res, body)
 	}
 	log.Printf("PATCH %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
 	return nil
 }
+
+type listHTTPResponse struct {
+	Data []struct {
+		ID         string          `json:"id"`
+		Attributes json.RawMessage `json:"attributes"`
+	} `json:"data"`
+	Pagination struct {
+		First string `json:"first"`
+		Last  string `json:"last"`
+		Prev  string `json:"prev"`
+		Next  string `json:"next"`
+	} `json:"pagination"`
+}
+
+// fetchAll calls f for every item of a list endpoint, following the pagination "next" link until all pages are consumed.
+func fetchAll(ctx context.Context, meta interface{}, path string, f func(id string, attributes json.RawMessage) error) diag.Diagnostics {
+	for path != "" {
+		log.Printf("GET %s", path)
+		res, err := meta.(*client).Get(ctx, path)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		body, err := ioutil.ReadAll(res.Body)
+		// Keep-Alive.
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		_ = res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return responseError(res, body)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("GET %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
+		var out listHTTPResponse
+		if err := json.Unmarshal(body, &out); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, e := range out.Data {
+			if err := f(e.ID, e.Attributes); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		path = ""
+		if out.Pagination.Next != "" {
+			// "next" is an absolute URL (e.g. "https://betteruptime.com/api/v2/monitors?page=2").
+			next, err := url.Parse(out.Pagination.Next)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			path = next.RequestURI()
+		}
+	}
+	return nil
+}
+
+// fetchOne is like fetchAll, but expects exactly one item to match. kind, kinds and by describe the
+// lookup in errors, e.g. "heartbeat", "heartbeats" and `named "example"`. The IDs of all matching
+// items are returned, even when there's more than one.
+func fetchOne(ctx context.Context, meta interface{}, path, kind, kinds, by string, match func(attributes json.RawMessage) (bool, error)) ([]string, diag.Diagnostics) {
+	var ids []string
+	if err := fetchAll(ctx, meta, path, func(id string, attributes json.RawMessage) error {
+		ok, err := match(attributes)
+		if ok {
+			ids = append(ids, id)
+		}
+		return err
+	}); err != nil {
+		return ids, err
+	}
+	switch len(ids) {
+	case 0:
+		return ids, diag.Errorf("no %s %s found", kind, by)
+	case 1:
+		return ids, nil
+	default:
+		return ids, diag.Errorf("found %d %s %s (IDs: %s)", len(ids), kinds, by, strings.Join(ids, ", "))
+	}
+}
+
 func resourceDelete(ctx context.Context, meta interface{}, url string) diag.Diagnostics {
 	log.Printf("DELETE %s", url)
 	res, err := meta.(*client).Delete(ctx, url)
@@ -105,8 +197,63 @@ func resourceDelete(ctx context.Context, meta interface{}, url string) diag.Diag
 	}()
 	body, _ := ioutil.ReadAll(res.Body)
 	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		return diag.Errorf("DELETE %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
+		return responseError(res, body)
 	}
 	log.Printf("DELETE %s returned %d: %s", res.Request.URL.String(), res.StatusCode, string(body))
 	return nil
 }
+
+// responseError turns an unexpected API response into a diagnostic, surfacing the messages of
+// error bodies like {"errors":{"url":["is not valid"]}} in the summary.
+func responseError(res *http.Response, body []byte) diag.Diagnostics {
+	detail := fmt.Sprintf("%s %s returned %d: %s", res.Request.Method, res.Request.URL.String(), res.StatusCode, string(body))
+	messages := apiErrorMessages(body)
+	if len(messages) == 0 {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: detail}}
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "betteruptime API error: " + strings.Join(messages, "; "),
+		Detail:   detail,
+	}}
+}
+
+func apiErrorMessages(body []byte) []string {
+	var out struct {
+		Errors interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil
+	}
+	list := func(v interface{}) []string {
+		switch v := v.(type) {
+		case string:
+			return []string{v}
+		case []interface{}:
+			var messages []string
+			for _, e := range v {
+				if s, ok := e.(string); ok {
+					messages = append(messages, s)
+				}
+			}
+			return messages
+		}
+		return nil
+	}
+	m, ok := out.Errors.(map[string]interface{})
+	if !ok {
+		return list(out.Errors)
+	}
+	fields := make([]string, 0, len(m))
+	for field := range m {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	var messages []string
+	for _, field := range fields {
+		for _, message := range list(m[field]) {
+			messages = append(messages, field+" "+message)
+		}
+	}
+	return messages
+}
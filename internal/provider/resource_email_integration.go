@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var emailIntegrationSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Email Integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the email integration that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"email_address": {
+		Description: "The unique email address you should forward alert emails to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"recovery_period": {
+		Description: "How long the integration must be quiet to automatically mark an incident as resolved. In seconds.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	},
+	"paused": {
+		Description: "Set to true to pause the email integration - we won't create incidents from received emails. Set to false to resume.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+	"call": {
+		Description: "Should we call the on-call person?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+	"sms": {
+		Description: "Should we send an SMS to the on-call person?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+	"email": {
+		Description: "Should we send an email to the on-call person?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	},
+	"push": {
+		Description: "Should we send a push notification to the on-call person?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	},
+}
+
+func newEmailIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: emailIntegrationCreate,
+		ReadContext:   emailIntegrationRead,
+		UpdateContext: emailIntegrationUpdate,
+		DeleteContext: emailIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/email-integrations-api",
+		Schema:      emailIntegrationSchema,
+	}
+}
+
+type emailIntegration struct {
+	Name           *string `json:"name,omitempty"`
+	EmailAddress   *string `json:"email_address,omitempty"`
+	RecoveryPeriod *int    `json:"recovery_period,omitempty"`
+	Paused         *bool   `json:"paused,omitempty"`
+	Call           *bool   `json:"call,omitempty"`
+	SMS            *bool   `json:"sms,omitempty"`
+	Email          *bool   `json:"email,omitempty"`
+	Push           *bool   `json:"push,omitempty"`
+}
+
+type emailIntegrationHTTPResponse struct {
+	Data struct {
+		ID         string           `json:"id"`
+		Attributes emailIntegration `json:"attributes"`
+	} `json:"data"`
+}
+
+func emailIntegrationRef(in *emailIntegration) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "email_address", v: &in.EmailAddress},
+		{k: "recovery_period", v: &in.RecoveryPeriod},
+		{k: "paused", v: &in.Paused},
+		{k: "call", v: &in.Call},
+		{k: "sms", v: &in.SMS},
+		{k: "email", v: &in.Email},
+		{k: "push", v: &in.Push},
+	}
+}
+
+func emailIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in emailIntegration
+	for _, e := range emailIntegrationRef(&in) {
+		load(d, e.k, e.v)
+	}
+	var out emailIntegrationHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/email-integrations", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return emailIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func emailIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out emailIntegrationHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/email-integrations/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return emailIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func emailIntegrationCopyAttrs(d *schema.ResourceData, in *emailIntegration) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range emailIntegrationRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
+
+func emailIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in emailIntegration
+	for _, e := range emailIntegrationRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/email-integrations/%s", url.PathEscape(d.Id())), &in)
+}
+
+func emailIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/email-integrations/%s", url.PathEscape(d.Id())))
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceEmailIntegration(t *testing.T) {
+	server := newComputedResourceServer(t, "/api/v2/email-integrations", "1", map[string]interface{}{
+		"email_address": "example.abcdef@betteruptime.com",
+	})
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_email_integration" "this" {
+					name = "%s"
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_email_integration.this", "id"),
+					resource.TestCheckResourceAttrSet("betteruptime_email_integration.this", "email_address"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "email", "true"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "push", "true"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_email_integration" "this" {
+					name            = "%s"
+					recovery_period = 600
+					call            = true
+					sms             = true
+					push            = false
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_email_integration.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "email_address", "example.abcdef@betteruptime.com"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "recovery_period", "600"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "call", "true"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "sms", "true"),
+					resource.TestCheckResourceAttr("betteruptime_email_integration.this", "push", "false"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_email_integration" "this" {
+					name            = "%s"
+					recovery_period = 600
+					call            = true
+					sms             = true
+					push            = false
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_email_integration.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
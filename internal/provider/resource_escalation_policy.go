@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var escalationPolicyStepTypes = []string{"team", "schedule", "person"}
+var escalationPolicyStepSchema = map[string]*schema.Schema{
+	"type": {
+		Description:  fmt.Sprintf("Who should be notified in this step. Valid values: %v.", escalationPolicyStepTypes),
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(escalationPolicyStepTypes, false),
+	},
+	"target_id": {
+		Description: "The ID of the team, on-call schedule or team member to notify (depending on type).",
+		Type:        schema.TypeInt,
+		Required:    true,
+	},
+	"wait_before_escalating": {
+		Description: "How long to wait before escalating to this step. In seconds.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+}
+
+var escalationPolicySchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Escalation Policy.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the escalation policy that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"repeat_count": {
+		Description: "How many times should the escalation policy be repeated if the incident isn't acknowledged?",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+	"repeat_delay": {
+		Description: "How long to wait before repeating the escalation policy. In seconds.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+	"step": {
+		Description: "An escalation step. Steps are executed in the order they are declared.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: escalationPolicyStepSchema,
+		},
+	},
+}
+
+func newEscalationPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: escalationPolicyCreate,
+		ReadContext:   escalationPolicyRead,
+		UpdateContext: escalationPolicyUpdate,
+		DeleteContext: escalationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/escalation-policies-api",
+		Schema:      escalationPolicySchema,
+	}
+}
+
+type escalationPolicyStep struct {
+	Type                 string `json:"type"`
+	TargetID             int    `json:"target_id"`
+	WaitBeforeEscalating int    `json:"wait_before_escalating"`
+}
+
+type escalationPolicy struct {
+	Name        *string                 `json:"name,omitempty"`
+	RepeatCount *int                    `json:"repeat_count,omitempty"`
+	RepeatDelay *int                    `json:"repeat_delay,omitempty"`
+	Steps       *[]escalationPolicyStep `json:"steps,omitempty"`
+}
+
+type escalationPolicyHTTPResponse struct {
+	Data struct {
+		ID         string           `json:"id"`
+		Attributes escalationPolicy `json:"attributes"`
+	} `json:"data"`
+}
+
+func escalationPolicyRef(in *escalationPolicy) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "repeat_count", v: &in.RepeatCount},
+		{k: "repeat_delay", v: &in.RepeatDelay},
+	}
+}
+
+func escalationPolicyLoadSteps(d *schema.ResourceData, in *escalationPolicy) {
+	steps := []escalationPolicyStep{}
+	for _, v := range d.Get("step").([]interface{}) {
+		m := v.(map[string]interface{})
+		steps = append(steps, escalationPolicyStep{
+			Type:                 m["type"].(string),
+			TargetID:             m["target_id"].(int),
+			WaitBeforeEscalating: m["wait_before_escalating"].(int),
+		})
+	}
+	in.Steps = &steps
+}
+
+func escalationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in escalationPolicy
+	for _, e := range escalationPolicyRef(&in) {
+		load(d, e.k, e.v)
+	}
+	escalationPolicyLoadSteps(d, &in)
+	var out escalationPolicyHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/escalation-policies", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return escalationPolicyCopyAttrs(d, &out.Data.Attributes)
+}
+
+func escalationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out escalationPolicyHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/escalation-policies/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return escalationPolicyCopyAttrs(d, &out.Data.Attributes)
+}
+
+func escalationPolicyCopyAttrs(d *schema.ResourceData, in *escalationPolicy) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range escalationPolicyRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	var steps []interface{}
+	if in.Steps != nil {
+		for _, s := range *in.Steps {
+			steps = append(steps, map[string]interface{}{
+				"type":                   s.Type,
+				"target_id":              s.TargetID,
+				"wait_before_escalating": s.WaitBeforeEscalating,
+			})
+		}
+	}
+	if err := d.Set("step", steps); err != nil {
+		derr = append(derr, diag.FromErr(err)[0])
+	}
+	return derr
+}
+
+func escalationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in escalationPolicy
+	for _, e := range escalationPolicyRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	if d.HasChange("step") {
+		escalationPolicyLoadSteps(d, &in)
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/escalation-policies/%s", url.PathEscape(d.Id())), &in)
+}
+
+func escalationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/escalation-policies/%s", url.PathEscape(d.Id())))
+}
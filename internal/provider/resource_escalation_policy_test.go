@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceEscalationPolicy(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/escalation-policies", "1")
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_escalation_policy" "this" {
+					name         = "%s"
+					repeat_count = 3
+
+					step {
+						type      = "team"
+						target_id = 2
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_escalation_policy.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "repeat_count", "3"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.0.type", "team"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_escalation_policy" "this" {
+					name         = "%s"
+					repeat_count = 5
+					repeat_delay = 60
+
+					step {
+						type      = "schedule"
+						target_id = 2
+					}
+
+					step {
+						type                   = "person"
+						target_id              = 3
+						wait_before_escalating = 180
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_escalation_policy.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "repeat_count", "5"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "repeat_delay", "60"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.0.type", "schedule"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.1.target_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_escalation_policy.this", "step.1.wait_before_escalating", "180"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_escalation_policy" "this" {
+					name         = "%s"
+					repeat_count = 5
+					repeat_delay = 60
+
+					step {
+						type      = "schedule"
+						target_id = 2
+					}
+
+					step {
+						type                   = "person"
+						target_id              = 3
+						wait_before_escalating = 180
+					}
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_escalation_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
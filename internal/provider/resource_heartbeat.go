@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var heartbeatSchema = map[string]*schema.Schema{
@@ -16,11 +18,27 @@ var heartbeatSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		// Heartbeats can't be moved to another team.
+		ForceNew: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Better Uptime may normalize the case of the team name.
+			return strings.EqualFold(old, new)
+		},
+	},
 	"name": {
 		Description: "A name of the service for this heartbeat.",
 		Type:        schema.TypeString,
 		Required:    true,
 	},
+	"heartbeat_url": {
+		Description: "The URL your service should send the heartbeat to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"period": {
 		Description: "How often should we expect this heartbeat? In seconds. Minimum value: 30 seconds",
 		Type:        schema.TypeInt,
@@ -33,6 +51,23 @@ var heartbeatSchema = map[string]*schema.Schema{
 		Required:    true,
 		// TODO: ValidateDiagFunc
 	},
+	"policy_id": {
+		Description: "Set the escalation policy for the heartbeat (see `betteruptime_policy`). If not set, the policy assigned by Better Uptime is kept.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+	},
+	"on_call_integration_id": {
+		Description: "The ID of the on-call calendar incidents of this heartbeat should be assigned to (see `betteruptime_on_call_calendar`).",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"incident_prefix": {
+		Description:  "A prefix added to the names of incidents of this heartbeat, to make them easy to filter in notifications. At most 50 characters.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 50),
+	},
 	"call": {
 		Description: "Should we call the on-call person?",
 		Type:        schema.TypeBool,
@@ -61,17 +96,15 @@ var heartbeatSchema = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	"heartbeat_group_id": {
-		Description: "Set this attribute if you want to add this heartbeat to a heartbeat group..",
+		Description: "Set this attribute if you want to add this heartbeat to a heartbeat group.",
 		Type:        schema.TypeInt,
 		Optional:    true,
 	},
 	"sort_index": {
-		Description: "An index controlling the position of a heartbeat in the heartbeat group.",
+		Description: "An index controlling the position of a heartbeat in the heartbeat group. If not set, the index assigned by Better Uptime is kept. Changes are applied in-place.",
 		Type:        schema.TypeInt,
 		Optional:    true,
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			return !d.HasChange(k)
-		},
+		Computed:    true,
 	},
 	"paused": {
 		Description: "Set to true to pause monitoring — we won't notify you about downtime. Set to false to resume monitoring.",
@@ -95,17 +128,22 @@ func newHeartbeatResource() *schema.Resource {
 }
 
 type heartbeat struct {
-	Name             *string `json:"name,omitempty"`
-	Period           *int    `json:"period,omitempty"`
-	Grace            *int    `json:"grace,omitempty"`
-	Call             *bool   `json:"call,omitempty"`
-	SMS              *bool   `json:"sms,omitempty"`
-	Email            *bool   `json:"email,omitempty"`
-	Push             *bool   `json:"push,omitempty"`
-	TeamWait         *int    `json:"team_wait,omitempty"`
-	HeartbeatGroupID *int    `json:"heartbeat_group_id,omitempty"`
-	SortIndex        *int    `json:"sort_index,omitempty"`
-	Paused           *bool   `json:"paused,omitempty"`
+	TeamName            *string `json:"team_name,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	URL                 *string `json:"url,omitempty"`
+	Period              *int    `json:"period,omitempty"`
+	Grace               *int    `json:"grace,omitempty"`
+	PolicyID            *string `json:"policy_id,omitempty"`
+	OnCallIntegrationID *string `json:"on_call_integration_id,omitempty"`
+	IncidentPrefix      *string `json:"incident_prefix,omitempty"`
+	Call                *bool   `json:"call,omitempty"`
+	SMS                 *bool   `json:"sms,omitempty"`
+	Email               *bool   `json:"email,omitempty"`
+	Push                *bool   `json:"push,omitempty"`
+	TeamWait            *int    `json:"team_wait,omitempty"`
+	HeartbeatGroupID    *int    `json:"heartbeat_group_id,omitempty"`
+	SortIndex           *int    `json:"sort_index,omitempty"`
+	Paused              *bool   `json:"paused,omitempty"`
 }
 
 type heartbeatHTTPResponse struct {
@@ -124,9 +162,14 @@ func heartbeatRef(in *heartbeat) []struct {
 		k string
 		v interface{}
 	}{
+		{k: "team_name", v: &in.TeamName},
 		{k: "name", v: &in.Name},
+		{k: "heartbeat_url", v: &in.URL},
 		{k: "period", v: &in.Period},
 		{k: "grace", v: &in.Grace},
+		{k: "policy_id", v: &in.PolicyID},
+		{k: "on_call_integration_id", v: &in.OnCallIntegrationID},
+		{k: "incident_prefix", v: &in.IncidentPrefix},
 		{k: "call", v: &in.Call},
 		{k: "sms", v: &in.SMS},
 		{k: "email", v: &in.Email},
@@ -143,6 +186,7 @@ func heartbeatCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	for _, e := range heartbeatRef(&in) {
 		load(d, e.k, e.v)
 	}
+	in.TeamName = defaultTeamName(meta, in.TeamName)
 	var out heartbeatHTTPResponse
 	if err := resourceCreate(ctx, meta, "/api/v2/heartbeats", &in, &out); err != nil {
 		return err
@@ -164,6 +208,13 @@ func heartbeatRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 func heartbeatCopyAttrs(d *schema.ResourceData, in *heartbeat) diag.Diagnostics {
 	var derr diag.Diagnostics
+	// Better Uptime doesn't always return the team name, so keep the one we know about.
+	if in.TeamName == nil {
+		if v, ok := d.GetOk("team_name"); ok {
+			t := v.(string)
+			in.TeamName = &t
+		}
+	}
 	for _, e := range heartbeatRef(in) {
 		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
 			derr = append(derr, diag.FromErr(err)[0])
@@ -1,7 +1,14 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,7 +17,10 @@ import (
 )
 
 func TestResourceHeartbeat(t *testing.T) {
-	server := newResourceServer(t, "/api/v2/heartbeats", "1")
+	server := newComputedResourceServer(t, "/api/v2/heartbeats", "1", map[string]interface{}{
+		"url":        "https://betteruptime.com/api/v1/heartbeat/example",
+		"sort_index": 7,
+	})
 	defer server.Close()
 
 	var name = "example"
@@ -41,6 +51,8 @@ func TestResourceHeartbeat(t *testing.T) {
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "name", name),
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "period", "30"),
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "grace", "0"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "heartbeat_url", "https://betteruptime.com/api/v1/heartbeat/example"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "sort_index", "7"),
 				),
 			},
 			// Step 2 - update.
@@ -51,9 +63,11 @@ func TestResourceHeartbeat(t *testing.T) {
 				}
 
 				resource "betteruptime_heartbeat" "this" {
-					name   = "%s"
-					period = 31
-					grace  = 1
+					name               = "%s"
+					period             = 31
+					grace              = 1
+					heartbeat_group_id = 2
+					sort_index         = 1
 				}
 				`, name),
 				Check: resource.ComposeTestCheckFunc(
@@ -61,9 +75,11 @@ func TestResourceHeartbeat(t *testing.T) {
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "name", name),
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "period", "31"),
 					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "grace", "1"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "heartbeat_group_id", "2"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "sort_index", "1"),
 				),
 			},
-			// Step 3 - make no changes, check plan is empty.
+			// Step 3 - pause.
 			{
 				Config: fmt.Sprintf(`
 				provider "betteruptime" {
@@ -71,14 +87,226 @@ func TestResourceHeartbeat(t *testing.T) {
 				}
 
 				resource "betteruptime_heartbeat" "this" {
-					name   = "%s"
-					period = 31
-					grace  = 1
+					name               = "%s"
+					period             = 31
+					grace              = 1
+					heartbeat_group_id = 2
+					paused             = true
 				}
 				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "paused", "true"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "heartbeat_url", "https://betteruptime.com/api/v1/heartbeat/example"),
+				),
+			},
+			// Step 4 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_heartbeat" "this" {
+					name               = "%s"
+					period             = 31
+					grace              = 1
+					heartbeat_group_id = 2
+					paused             = true
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 5 - destroy.
+			{
+				ResourceName:      "betteruptime_heartbeat.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceHeartbeatTeamName(t *testing.T) {
+	handler := newResourceHandler(t, "/api/v2/heartbeats", "1", nil)
+	// Better Uptime takes team_name in the request body, but doesn't return it.
+	server := httptest.NewServer(omitAttributes(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var in map[string]interface{}
+			if err := json.Unmarshal(body, &in); err != nil {
+				t.Fatal(err)
+			}
+			if in["team_name"] != "Platform" {
+				t.Errorf("expected team_name Platform, got %v", in["team_name"])
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		handler.ServeHTTP(w, r)
+	}), "team_name"))
+	defer server.Close()
+
+	config := func(teamName string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_heartbeat" "this" {
+			team_name = %q
+			name      = "example"
+			period    = 30
+			grace     = 0
+		}
+		`, teamName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "team_name", "Platform"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config("Platform"),
+				PlanOnly: true,
+			},
+			// Step 3 - change the case only, check plan is empty.
+			{
+				Config:   config("platform"),
+				PlanOnly: true,
+			},
+			// Step 4 - move to another team, check plan is not empty.
+			{
+				Config:             config("Infrastructure"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceHeartbeatNotifications(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/heartbeats", "1")
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_heartbeat" "this" {
+		name   = "example"
+		period = 30
+		grace  = 0
+		call   = false
+		sms    = false
+		email  = false
+		push   = false
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "call", "false"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "sms", "false"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "email", "false"),
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "push", "false"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Step 3 - destroy.
+			{
+				ResourceName:      "betteruptime_heartbeat.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceHeartbeatPolicy(t *testing.T) {
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/heartbeats": newResourceHandler(t, "/api/v2/heartbeats", "1", nil),
+		"/api/v2/policies":   newResourceHandler(t, "/api/v2/policies", "2", nil),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_policy" "this" {
+		name = "example"
+
+		step {
+			type             = "email"
+			all_team_members = true
+		}
+	}
+
+	resource "betteruptime_heartbeat" "this" {
+		name      = "example"
+		period    = 30
+		grace     = 0
+		policy_id = betteruptime_policy.this.id
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "policy_id", "2"),
+					resource.TestCheckResourceAttrPair("betteruptime_heartbeat.this", "policy_id", "betteruptime_policy.this", "id"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
 				PlanOnly: true,
 			},
-			// Step 4 - destroy.
+			// Step 3 - destroy.
 			{
 				ResourceName:      "betteruptime_heartbeat.this",
 				ImportState:       true,
@@ -87,3 +315,120 @@ func TestResourceHeartbeat(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceHeartbeatOnCallCalendar(t *testing.T) {
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/heartbeats":                  newResourceHandler(t, "/api/v2/heartbeats", "1", nil),
+		"/api/v2/on-call-calendars":           newResourceHandler(t, "/api/v2/on-call-calendars", "2", nil),
+		"/api/v2/on-call-calendars/2/members": newOnCallCalendarMembersHandler(t, "2"),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_on_call_calendar" "this" {
+		name = "primary"
+	}
+
+	resource "betteruptime_heartbeat" "this" {
+		name                   = "example"
+		period                 = 30
+		grace                  = 0
+		on_call_integration_id = betteruptime_on_call_calendar.this.id
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "on_call_integration_id", "2"),
+					resource.TestCheckResourceAttrPair("betteruptime_heartbeat.this", "on_call_integration_id", "betteruptime_on_call_calendar.this", "id"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Step 3 - destroy.
+			{
+				ResourceName:      "betteruptime_heartbeat.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceHeartbeatIncidentPrefix(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/heartbeats", "1")
+	defer server.Close()
+
+	config := func(prefix string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_heartbeat" "this" {
+			name            = "example"
+			period          = 30
+			grace           = 0
+			incident_prefix = "%s"
+		}
+		`, prefix)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject a prefix longer than 50 characters.
+			{
+				Config:      config(strings.Repeat("x", 51)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected length of incident_prefix to be in the range \(0 - 50\)`),
+			},
+			// Step 2 - create.
+			{
+				Config: config("[prod]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "incident_prefix", "[prod]"),
+				),
+			},
+			// Step 3 - update.
+			{
+				Config: config("[staging]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_heartbeat.this", "incident_prefix", "[staging]"),
+				),
+			},
+			// Step 4 - make no changes, check plan is empty.
+			{
+				Config:   config("[staging]"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var incomingWebhookFieldTargets = []string{"json", "query_string", "header"}
+var incomingWebhookFieldSchema = map[string]*schema.Schema{
+	"field_target": {
+		Description:  fmt.Sprintf("Which part of the incoming request the value should be extracted from. Valid values: %v.", incomingWebhookFieldTargets),
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "json",
+		ValidateFunc: validation.StringInSlice(incomingWebhookFieldTargets, false),
+	},
+	"name": {
+		Description: "The name of the JSON key (dot-separated for nested keys), query string parameter or header to extract the value from.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+}
+
+var incomingWebhookSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Incoming Webhook.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		// Incoming webhooks can't be moved to another team.
+		ForceNew: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Better Uptime may normalize the case of the team name.
+			return strings.EqualFold(old, new)
+		},
+	},
+	"name": {
+		Description: "A name of the incoming webhook that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"webhook_url": {
+		Description: "The URL you should send the webhook payloads to.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"recovery_period": {
+		Description: "How long the webhook must be quiet to automatically mark an incident as resolved. In seconds.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	},
+	"paused": {
+		Description: "Set to true to pause the incoming webhook - we won't create incidents from received payloads. Set to false to resume.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+	"title_field": {
+		Description: "Where to take the incident title from in the incoming payload.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: incomingWebhookFieldSchema,
+		},
+	},
+	"cause_field": {
+		Description: "Where to take the incident cause from in the incoming payload.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: incomingWebhookFieldSchema,
+		},
+	},
+}
+
+func newIncomingWebhookResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: incomingWebhookCreate,
+		ReadContext:   incomingWebhookRead,
+		UpdateContext: incomingWebhookUpdate,
+		DeleteContext: incomingWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/incoming-webhooks-api",
+		Schema:      incomingWebhookSchema,
+	}
+}
+
+type incomingWebhookField struct {
+	FieldTarget string `json:"field_target"`
+	Name        string `json:"name"`
+}
+
+type incomingWebhook struct {
+	TeamName       *string               `json:"team_name,omitempty"`
+	Name           *string               `json:"name,omitempty"`
+	URL            *string               `json:"url,omitempty"`
+	RecoveryPeriod *int                  `json:"recovery_period,omitempty"`
+	Paused         *bool                 `json:"paused,omitempty"`
+	TitleField     *incomingWebhookField `json:"title_field,omitempty"`
+	CauseField     *incomingWebhookField `json:"cause_field,omitempty"`
+}
+
+type incomingWebhookHTTPResponse struct {
+	Data struct {
+		ID         string          `json:"id"`
+		Attributes incomingWebhook `json:"attributes"`
+	} `json:"data"`
+}
+
+func incomingWebhookRef(in *incomingWebhook) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "team_name", v: &in.TeamName},
+		{k: "name", v: &in.Name},
+		{k: "webhook_url", v: &in.URL},
+		{k: "recovery_period", v: &in.RecoveryPeriod},
+		{k: "paused", v: &in.Paused},
+	}
+}
+
+func incomingWebhookFieldRef(in *incomingWebhook) []struct {
+	k string
+	v **incomingWebhookField
+} {
+	return []struct {
+		k string
+		v **incomingWebhookField
+	}{
+		{k: "title_field", v: &in.TitleField},
+		{k: "cause_field", v: &in.CauseField},
+	}
+}
+
+func incomingWebhookLoadField(d *schema.ResourceData, key string, receiver **incomingWebhookField) {
+	// Send an empty mapping (rather than nothing) when the block is removed.
+	*receiver = &incomingWebhookField{}
+	if v := d.Get(key).([]interface{}); len(v) != 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		*receiver = &incomingWebhookField{
+			FieldTarget: m["field_target"].(string),
+			Name:        m["name"].(string),
+		}
+	}
+}
+
+func incomingWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in incomingWebhook
+	for _, e := range incomingWebhookRef(&in) {
+		load(d, e.k, e.v)
+	}
+	for _, e := range incomingWebhookFieldRef(&in) {
+		if _, ok := d.GetOk(e.k); ok {
+			incomingWebhookLoadField(d, e.k, e.v)
+		}
+	}
+	in.TeamName = defaultTeamName(meta, in.TeamName)
+	var out incomingWebhookHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/incoming-webhooks", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return incomingWebhookCopyAttrs(d, &out.Data.Attributes)
+}
+
+func incomingWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out incomingWebhookHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/incoming-webhooks/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return incomingWebhookCopyAttrs(d, &out.Data.Attributes)
+}
+
+func incomingWebhookCopyAttrs(d *schema.ResourceData, in *incomingWebhook) diag.Diagnostics {
+	var derr diag.Diagnostics
+	// Better Uptime doesn't always return the team name, so keep the one we know about.
+	if in.TeamName == nil {
+		if v, ok := d.GetOk("team_name"); ok {
+			t := v.(string)
+			in.TeamName = &t
+		}
+	}
+	for _, e := range incomingWebhookRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	for _, e := range incomingWebhookFieldRef(in) {
+		var v []interface{}
+		if f := *e.v; f != nil && f.Name != "" {
+			v = append(v, map[string]interface{}{
+				"field_target": f.FieldTarget,
+				"name":         f.Name,
+			})
+		}
+		if err := d.Set(e.k, v); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
+
+func incomingWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in incomingWebhook
+	for _, e := range incomingWebhookRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	for _, e := range incomingWebhookFieldRef(&in) {
+		if d.HasChange(e.k) {
+			incomingWebhookLoadField(d, e.k, e.v)
+		}
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/incoming-webhooks/%s", url.PathEscape(d.Id())), &in)
+}
+
+func incomingWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/incoming-webhooks/%s", url.PathEscape(d.Id())))
+}
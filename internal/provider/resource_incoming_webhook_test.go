@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceIncomingWebhook(t *testing.T) {
+	server := newComputedResourceServer(t, "/api/v2/incoming-webhooks", "1", map[string]interface{}{
+		"url": "https://betteruptime.com/api/v1/incoming-webhook/example",
+	})
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_incoming_webhook" "this" {
+					name            = "%s"
+					recovery_period = 0
+
+					title_field {
+						name = "alert.title"
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_incoming_webhook.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "webhook_url", "https://betteruptime.com/api/v1/incoming-webhook/example"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "title_field.0.field_target", "json"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "title_field.0.name", "alert.title"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "cause_field.#", "0"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_incoming_webhook" "this" {
+					name            = "%s"
+					recovery_period = 300
+					paused          = true
+
+					title_field {
+						name = "alert.title"
+					}
+
+					cause_field {
+						field_target = "header"
+						name         = "X-Alert-Cause"
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_incoming_webhook.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "recovery_period", "300"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "paused", "true"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "cause_field.0.field_target", "header"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "cause_field.0.name", "X-Alert-Cause"),
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "webhook_url", "https://betteruptime.com/api/v1/incoming-webhook/example"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_incoming_webhook" "this" {
+					name            = "%s"
+					recovery_period = 300
+					paused          = true
+
+					title_field {
+						name = "alert.title"
+					}
+
+					cause_field {
+						field_target = "header"
+						name         = "X-Alert-Cause"
+					}
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_incoming_webhook.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceIncomingWebhookTeamName(t *testing.T) {
+	var teams []string
+	var deletes int
+	handler := newResourceHandler(t, "/api/v2/incoming-webhooks", "1", nil)
+	// Better Uptime takes team_name in the request body, but doesn't return it.
+	server := httptest.NewServer(omitAttributes(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var in map[string]interface{}
+			if err := json.Unmarshal(body, &in); err != nil {
+				t.Fatal(err)
+			}
+			teams = append(teams, fmt.Sprint(in["team_name"]))
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		case http.MethodDelete:
+			deletes++
+		}
+		handler.ServeHTTP(w, r)
+	}), "team_name"))
+	defer server.Close()
+
+	config := func(teamName string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_incoming_webhook" "this" {
+			team_name       = %q
+			name            = "example"
+			recovery_period = 0
+		}
+		`, teamName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "team_name", "Platform"),
+				),
+			},
+			// Step 2 - change the case only, check plan is empty.
+			{
+				Config:   config("platform"),
+				PlanOnly: true,
+			},
+			// Step 3 - move to another team, check the incoming webhook is replaced.
+			{
+				Config: config("Infrastructure"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_incoming_webhook.this", "team_name", "Infrastructure"),
+					func(s *terraform.State) error {
+						if deletes != 1 || len(teams) != 2 || teams[1] != "Infrastructure" {
+							return fmt.Errorf("expected the incoming webhook to be replaced, got %d deletes and creates in %v", deletes, teams)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
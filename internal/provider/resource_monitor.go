@@ -2,18 +2,83 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/url"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // TODO: change to map<name, description> and then use to gen monitor_type description
-var monitorTypes = []string{"status", "keyword", "keyword_absence", "ping", "tcp", "udp", "smtp", "pop", "imap"}
+var monitorTypes = []string{"status", "expected_status_code", "keyword", "keyword_absence", "javascript", "multihttp", "ping", "tcp", "udp", "smtp", "pop", "imap"}
+
+var monitorAuthSchemes = []string{"basic", "digest", "bearer"}
+
+var monitorHTTPMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+var monitorStepAssertionSchema = map[string]*schema.Schema{
+	"type": {
+		Description: "What the assertion checks (e.g. the status code or the body of the response).",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"value": {
+		Description: "The value the response is checked against.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+}
+
+var monitorStepSchema = map[string]*schema.Schema{
+	"url": {
+		Description: "URL requested in this step.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"method": {
+		Description:  "HTTP Method used to make the request. Valid options (case-insensitive): GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "GET",
+		ValidateFunc: validation.StringInSlice(monitorHTTPMethods, true),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+	},
+	"request_headers": {
+		Description: "Custom HTTP headers sent with the request, keyed by header name.",
+		Type:        schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
+	},
+	"request_body": {
+		Description: "Request body for POST, PUT or PATCH requests.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"assertion": {
+		Description: "A check the response must pass before the next step is executed.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: monitorStepAssertionSchema,
+		},
+	},
+}
+
 var monitorSchema = map[string]*schema.Schema{
 	"id": {
 		Description: "The ID of this Monitor.",
@@ -22,16 +87,44 @@ var monitorSchema = map[string]*schema.Schema{
 	},
 	"ssl_expiration": {
 		Description: "How many days before the SSL certificate expires do you want to be alerted?" +
+			" Must be between 1 and 90 (e.g. 1, 2, 3, 7, 14, 30, or 60).",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, 90),
+	},
+	"domain_expiration": {
+		Description: "How many days before the domain expires do you want to be alerted?" +
 			" Valid values are 1, 2, 3, 7, 14, 30, and 60.",
 		Type:     schema.TypeInt,
 		Optional: true,
-		// TODO: ValidateDiagFunc: validation.IntInSlice
 	},
 	"policy_id": {
-		Description: "Set the escalation policy for the monitor.",
+		Description: "Set the escalation policy for the monitor (see `betteruptime_policy`). If not set, the policy assigned by Better Uptime is kept.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+	},
+	"escalation_policy_id": {
+		Description: "The ID of the escalation policy incidents of this monitor should follow (see `betteruptime_escalation_policy`). Takes precedence over the team's default policy. Can't be set together with `policy_id`.",
 		Type:        schema.TypeString,
 		Optional:    true,
 	},
+	"on_call_integration_id": {
+		Description: "The ID of the on-call calendar incidents of this monitor should be assigned to (see `betteruptime_on_call_calendar`).",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		// Monitors can't be moved to another team.
+		ForceNew: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Better Uptime may normalize the case of the team name.
+			return strings.EqualFold(old, new)
+		},
+	},
 	"url": {
 		Description: "URL of your website or the host you want to ping (see monitor_type below).",
 		Type:        schema.TypeString,
@@ -42,10 +135,16 @@ var monitorSchema = map[string]*schema.Schema{
 
     **status** We will check your website for 2XX HTTP status code.
 
+    **expected_status_code** We will check if your website returns one of the expected_status_codes.
+
     **keyword** We will check if your website contains the required_keyword.
 
     **keyword_absence** We will check if your website doesn't contain the required_keyword.
 
+    **javascript** We will run the javascript snippet to check your website.
+
+    **multihttp** We will run a sequence of HTTP requests against your website.
+
     **ping** We will ping your host specified in the url parameter.
 
     **tcp** We will test a TCP port at your host specified in the url parameter
@@ -76,16 +175,42 @@ var monitorSchema = map[string]*schema.Schema{
 					AttributePath: path,
 					Severity:      diag.Error,
 					Summary:       `Invalid "monitor_type"`,
-					Detail:        fmt.Sprintf("Expected one of %v", monitorTypes),
+					Detail:        fmt.Sprintf("Expected one of %v, got %q.", monitorTypes, s),
 				},
 			}
 		},
 	},
 	"required_keyword": {
-		Description: "Required if monitor_type is set to keyword  or udp. We will create a new incident if this keyword is missing on your page.",
+		Description: "Required if monitor_type is set to keyword, keyword_absence or udp. We will create a new incident if this keyword is missing on your page (or present, for keyword_absence).",
 		Type:        schema.TypeString,
 		Optional:    true,
 	},
+	"javascript": {
+		Description: "Required if monitor_type is set to javascript. The JavaScript snippet we will run to check your website. Marked as sensitive, as scripts may contain secrets.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"alert_email_subject": {
+		Description:  "The subject of the email we send when an incident is started. May contain the variables `{{url}}`, `{{pronounceable_name}}`, `{{monitor_type}}` and `{{cause}}`. If not set, Better Uptime's default subject is used.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.All(validation.StringIsNotEmpty, validateMonitorEmailSubject),
+	},
+	"recovery_email_subject": {
+		Description:  "The subject of the email we send when the monitor recovers. May contain the variables `{{url}}`, `{{pronounceable_name}}`, `{{monitor_type}}` and `{{cause}}`. If not set, Better Uptime's default subject is used.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.All(validation.StringIsNotEmpty, validateMonitorEmailSubject),
+	},
+	"create_incident": {
+		Description: "Should we open an incident when the monitor detects a failure?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	},
 	"call": {
 		Description: "Should we call the on-call person?",
 		Type:        schema.TypeBool,
@@ -108,6 +233,20 @@ var monitorSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Default:     true,
 	},
+	"sms_verification": {
+		Description: "Should we require SMS verification before the monitor can be resumed (un-paused)? Doesn't affect how the on-call person is notified.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+	"team_member_ids_for_notifications": {
+		Description: "A set of team member IDs to notify about incidents of this monitor. When set, it overrides the recipients of the escalation policy (see `policy_id`); leave blank to notify according to the policy.",
+		Type:        schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Set:      schema.HashString,
+		Optional: true,
+	},
 	"team_wait": {
 		Description: "How long to wait before escalating the incident alert to the team. Leave blank to disable escalating to the entire team.",
 		Type:        schema.TypeInt,
@@ -118,39 +257,113 @@ var monitorSchema = map[string]*schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
 	},
+	"paused_at": {
+		Description: "When the monitor was paused (RFC 3339). Empty if the monitor isn't paused.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"status": {
+		Description: "The current state of the monitor (e.g. up, down, paused, pending, maintenance or validating), as of the last refresh.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"last_checked_at": {
+		Description: "When the monitor was last checked (RFC 3339, UTC), as of the last refresh. Empty until the first check.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"last_incident_id": {
+		Description: "The ID of the most recent incident of this monitor (see `betteruptime_incident`), as of the last refresh. Empty if the monitor has never had an incident.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"created_at": {
+		Description: "When the monitor was created (RFC 3339, UTC).",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"updated_at": {
+		Description: "When the monitor was last updated (RFC 3339, UTC).",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"port": {
 		Description: "Required if monitor_type is set to tcp, udp, smtp, pop, or imap." +
 			" tcp and udp monitors accept any ports, while smtp, pop, and imap accept only the specified ports corresponding with their servers (e.g. \"25,465,587\" for smtp).",
 		Type:     schema.TypeString,
 		Optional: true,
+		ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+			for _, port := range strings.Split(v.(string), ",") {
+				if n, err := strconv.Atoi(strings.TrimSpace(port)); err != nil || n < 1 || n > 65535 {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							AttributePath: path,
+							Severity:      diag.Error,
+							Summary:       `Invalid "port"`,
+							Detail:        fmt.Sprintf("Expected a port number between 1 and 65535 (or a comma-separated list of those), got %q", v),
+						},
+					}
+				}
+			}
+			return nil
+		},
 	},
 	"regions": {
-		Description: "An array of regions to set. Allowed values are [\"us\", \"eu\", \"as\", \"au\"] or any subset of these regions.",
-		Type:        schema.TypeList,
+		Description: "A set of regions to check from. Allowed values are [\"us\", \"eu\", \"as\", \"au\"] (case-insensitive) or any subset of these regions. Leave blank to check from the default regions.",
+		Type:        schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"us", "eu", "as", "au"}, true),
+			// Better Uptime returns region codes in lower case.
+			StateFunc: func(v interface{}) string {
+				return strings.ToLower(v.(string))
+			},
+		},
+		Set: func(v interface{}) int {
+			return schema.HashString(strings.ToLower(v.(string)))
+		},
+		Optional: true,
+	},
+	"split_signal": {
+		Description: "Should we open a separate incident for each failing region (see `regions`) instead of a single incident for the monitor?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"tags": {
+		Description: "A set of tags to help you filter and organize your monitors.",
+		Type:        schema.TypeSet,
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
 		Optional: true,
-		// TODO: ValidateDiagFunc
 	},
 	"monitor_group_id": {
-		Description: "Set this attribute if you want to add this monitor to a monitor group.",
+		Description: "Set this attribute if you want to add this monitor to a monitor group (see `betteruptime_monitor_group`).",
 		Type:        schema.TypeInt,
 		Optional:    true,
 	},
 	"pronounceable_name": {
-		Description: "Pronounceable name of the monitor. We will use this when we call you. Try to make it tongue-friendly, please?",
+		Description: "Pronounceable name of the monitor. We will use this when we call you. Try to make it tongue-friendly, please? Must be unique within the team.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Omitting pronounceable_name keeps whatever Better Uptime has (computed or previously set).
 			return new == "" || old == new
 		},
 	},
+	"incident_prefix": {
+		Description:  "A prefix added to the names of incidents of this monitor, to make them easy to filter in notifications. At most 50 characters.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 50),
+	},
 	"recovery_period": {
-		Description: "How long the monitor must be up to automatically mark an incident as resolved after being down.",
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     180,
+		Description:  "How long the monitor must be up to automatically mark an incident as resolved after being down. In seconds.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      180,
+		ValidateFunc: validation.IntAtLeast(0),
 	},
 	"verify_ssl": {
 		Description: "Should we verify SSL certificate validity?",
@@ -158,64 +371,207 @@ var monitorSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Default:     true,
 	},
-	"check_frequency": {
-		Description: "How often should we check your website? In seconds.",
-		Type:        schema.TypeInt,
+	"follow_redirects": {
+		Description: "Should we follow redirects when sending the HTTP request?",
+		Type:        schema.TypeBool,
 		Optional:    true,
-		Default:     180,
+		Default:     true,
+	},
+	"screenshot": {
+		Description: "Should we capture a screenshot of the page when the monitor goes down?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+	},
+	"check_frequency": {
+		Description:  "How often should we check your website? In seconds. Valid values are 30, 60, 120, 180, 300, and 600.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntInSlice([]int{30, 60, 120, 180, 300, 600}),
 	},
 	"confirmation_period": {
-		Description: "How long should we wait after observing a failure before we start a new incident?",
+		Description: "How long should we wait after observing a failure before we start a new incident? In seconds. Defaults to 0 (start an incident right away).",
 		Type:        schema.TypeInt,
 		Optional:    true,
 	},
 	"http_method": {
-		Description: "HTTP Method used to make a request. Valid options: GET, HEAD, POST, PUT, PATCH",
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "GET",
+		Description:  "HTTP Method used to make a request. Valid options (case-insensitive): GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "GET",
+		ValidateFunc: validation.StringInSlice(monitorHTTPMethods, true),
 		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 			return strings.EqualFold(old, new)
 		},
-		// TODO: ValidateDiagFunc: validation.StringInSlice
 	},
 	"request_timeout": {
-		Description: "How long to wait before timing out the request? In seconds.",
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     30,
+		Description:  "How long to wait before timing out the request? In seconds. Must be between 1 and 60.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      30,
+		ValidateFunc: validation.IntBetween(1, 60),
+	},
+	"tcp_timeout": {
+		Description:  "How long to wait before timing out a tcp monitor's connection? In seconds. Must be between 1 and 60. Ignored for other monitor types.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 60),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Get("monitor_type").(string) != "tcp"
+		},
+	},
+	"udp_timeout": {
+		Description:  "How long to wait before timing out a udp monitor's connection? In seconds. Must be between 1 and 60. Ignored for other monitor types.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 60),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Get("monitor_type").(string) != "udp"
+		},
+	},
+	"response_time_threshold": {
+		Description:  "We will alert you when the response time exceeds this threshold. In milliseconds.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	},
+	"availability_threshold": {
+		Description:  "We will alert you when the availability of the monitor drops below this threshold. In percent, between 0 and 100.",
+		Type:         schema.TypeFloat,
+		Optional:     true,
+		ValidateFunc: validation.FloatBetween(0, 100),
+	},
+	"expected_status_codes": {
+		Description: "Required if monitor_type is set to expected_status_code. We will create a new incident if the status code returned from the server is not in the list of expected status codes.",
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(100, 599),
+		},
+		Optional: true,
+	},
+	"request_headers": {
+		Description: "Custom HTTP headers to send with each check, as a map of header names to values.",
+		Type:        schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
 	},
 	"request_body": {
-		Description: "Request body for POST, PUT, PATCH requests.",
+		Description: "Request body for POST, PUT, PATCH requests. Ignored for GET and HEAD requests; `terraform apply` warns about it, while `terraform plan` only logs the warning (visible with `TF_LOG=WARN`).",
 		Type:        schema.TypeString,
 		Optional:    true,
 	},
+	"step": {
+		Description: "Required if monitor_type is set to multihttp. A request made by the monitor. Steps are executed in the order they are declared.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: monitorStepSchema,
+		},
+	},
+	"auth_scheme": {
+		Description:  fmt.Sprintf("How the request is authenticated. Valid values: %v. `basic` requires `basic_auth_username`, `digest` requires `auth_username`, and `bearer` requires an `Authorization` header in `request_headers`; credentials of the other schemes can't be set. Only used to validate the configuration, it isn't sent to Better Uptime.", monitorAuthSchemes),
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(monitorAuthSchemes, false),
+	},
+	"basic_auth_username": {
+		Description: "HTTP Basic authentication username to include with the request.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"basic_auth_password": {
+		Description: "HTTP Basic authentication password to include with the request (see `basic_auth_username`).",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	},
 	"auth_username": {
-		Description: "Basic HTTP authentication username to include with the request.",
+		Description: "HTTP Digest authentication username to include with the request. For HTTP Basic authentication, use `basic_auth_username` instead; both can be set. For other schemes (e.g. a bearer token), set an `Authorization` header in `request_headers` instead, but not together with any of these credentials.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
 	},
 	"auth_password": {
-		Description: "Basic HTTP authentication password to include with the request.",
+		Description: "HTTP Digest authentication password to include with the request (see `auth_username`).",
 		Type:        schema.TypeString,
 		Optional:    true,
 		Sensitive:   true,
 	},
 	"maintenance_from": {
-		Description: "Start of the maintenance window each day. We won't check your website during this window. In UTC timezone. Example: \"01:00:00\"",
-		Type:        schema.TypeString,
-		Optional:    true,
-		// TODO: ValidateDiagFunc
+		Description:      "Start of the maintenance window each day. We won't check your website during this window. In HH:MM or HH:MM:SS format. Example: \"01:00\"",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringMatch(monitorMaintenanceTimeRegexp, "must be in HH:MM or HH:MM:SS format"),
+		DiffSuppressFunc: monitorMaintenanceTimeDiffSuppress,
 	},
 	"maintenance_to": {
-		Description: "End of the maintenance window each day. In UTC timezone. Example: \"03:00:00\"",
+		Description:      "End of the maintenance window each day. In HH:MM or HH:MM:SS format. Example: \"03:00\"",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringMatch(monitorMaintenanceTimeRegexp, "must be in HH:MM or HH:MM:SS format"),
+		DiffSuppressFunc: monitorMaintenanceTimeDiffSuppress,
+	},
+	"maintenance_days": {
+		Description: "Days of the week the maintenance window applies to (0 = Monday, 6 = Sunday). Leave blank for every day.",
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(0, 6),
+		},
+		Optional: true,
+	},
+	"maintenance_timezone": {
+		Description: "The timezone to use for the maintenance window each day. The accepted values can be found in the Rails TimeZone documentation. https://api.rubyonrails.org/classes/ActiveSupport/TimeZone.html",
 		Type:        schema.TypeString,
 		Optional:    true,
-		// TODO: ValidateDiagFunc
+		Default:     "UTC",
 	},
 }
 
+var monitorMaintenanceTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9])?$`)
+
+// monitorMaintenanceTimeDiffSuppress treats "01:00" and "01:00:00" as equal, as Better Uptime returns the latter.
+func monitorMaintenanceTimeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(v string) string {
+		if len(v) == len("15:04") {
+			return v + ":00"
+		}
+		return v
+	}
+	return normalize(old) == normalize(new)
+}
+
+// monitorEmailSubjectVariables are the template variables Better Uptime substitutes in email subjects.
+var monitorEmailSubjectVariables = []string{"url", "pronounceable_name", "monitor_type", "cause"}
+
+// monitorEmailSubjectVariableRegexp matches a template variable (e.g. "{{url}}") in an email subject.
+var monitorEmailSubjectVariableRegexp = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+func validateMonitorEmailSubject(v interface{}, k string) (ws []string, es []error) {
+	for _, m := range monitorEmailSubjectVariableRegexp.FindAllStringSubmatch(v.(string), -1) {
+		known := false
+		for _, name := range monitorEmailSubjectVariables {
+			if m[1] == name {
+				known = true
+			}
+		}
+		if !known {
+			es = append(es, fmt.Errorf("expected %s to only contain the variables {{%s}}, got %s", k, strings.Join(monitorEmailSubjectVariables, "}}, {{"), m[0]))
+		}
+	}
+	if rest := monitorEmailSubjectVariableRegexp.ReplaceAllString(v.(string), ""); strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		es = append(es, fmt.Errorf("expected %s to only contain variables of the form {{variable}}, got %q", k, v))
+	}
+	return
+}
+
 func newMonitorResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: monitorCreate,
@@ -223,40 +579,226 @@ func newMonitorResource() *schema.Resource {
 		UpdateContext: monitorUpdate,
 		DeleteContext: monitorDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Monitors of a non-default team can be imported via "id/team_name".
+				if split := strings.SplitN(d.Id(), "/", 2); len(split) == 2 {
+					if split[0] == "" || split[1] == "" {
+						return nil, errors.New("betteruptime_monitor can be imported via \"id\" or \"id/team_name\" only (e.g. \"1\" or \"1/Platform\")")
+					}
+					if err := d.Set("team_name", split[1]); err != nil {
+						return nil, err
+					}
+					d.SetId(split[0])
+				}
+				return []*schema.ResourceData{d}, nil
+			},
 		},
-		Description: "https://docs.betteruptime.com/api/monitors-api",
-		Schema:      monitorSchema,
+		CustomizeDiff: monitorCustomizeDiff,
+		Description:   "https://docs.betteruptime.com/api/monitors-api",
+		Schema:        monitorSchema,
+	}
+}
+
+func monitorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("paused") {
+		// Better Uptime sets (or clears) paused_at when the monitor is paused (or resumed).
+		if err := d.SetNewComputed("paused_at"); err != nil {
+			return err
+		}
+	}
+	if d.Get("escalation_policy_id").(string) != "" || !d.NewValueKnown("escalation_policy_id") {
+		// policy_id is computed, so its value here may be the policy assigned by Better Uptime rather than one from
+		// the configuration; only a policy_id that is being changed must come from the configuration.
+		if d.HasChange("policy_id") && (d.Get("policy_id").(string) != "" || !d.NewValueKnown("policy_id")) {
+			return fmt.Errorf("policy_id can't be set together with escalation_policy_id")
+		}
+	}
+	if d.NewValueKnown("request_headers") {
+		for _, k := range []string{"basic_auth_username", "basic_auth_password", "auth_username", "auth_password"} {
+			if d.Get(k).(string) == "" {
+				continue
+			}
+			for name := range d.Get("request_headers").(map[string]interface{}) {
+				if strings.EqualFold(name, "Authorization") {
+					return fmt.Errorf("request_headers can't set %q when %s is set", name, k)
+				}
+			}
+		}
+	}
+	if scheme := d.Get("auth_scheme").(string); scheme != "" && d.NewValueKnown("auth_scheme") {
+		credentials := map[string][]string{
+			"basic":  {"basic_auth_username", "basic_auth_password"},
+			"digest": {"auth_username", "auth_password"},
+		}
+		for _, s := range []string{"basic", "digest"} {
+			for _, k := range credentials[s] {
+				if s != scheme && d.Get(k).(string) != "" {
+					return fmt.Errorf("%s can't be set when auth_scheme is %q", k, scheme)
+				}
+			}
+		}
+		switch scheme {
+		case "basic", "digest":
+			if k := credentials[scheme][0]; d.NewValueKnown(k) && d.Get(k).(string) == "" {
+				return fmt.Errorf("%s must be set when auth_scheme is %q", k, scheme)
+			}
+		case "bearer":
+			var found bool
+			for name := range d.Get("request_headers").(map[string]interface{}) {
+				found = found || strings.EqualFold(name, "Authorization")
+			}
+			if d.NewValueKnown("request_headers") && !found {
+				return fmt.Errorf("request_headers must set an Authorization header when auth_scheme is %q", scheme)
+			}
+		}
+	}
+	if d.HasChange("pronounceable_name") && d.NewValueKnown("pronounceable_name") && d.NewValueKnown("team_name") {
+		if name := d.Get("pronounceable_name").(string); name != "" {
+			if err := monitorCheckPronounceableName(ctx, d, meta, name); err != nil {
+				return err
+			}
+		}
+	}
+	if d.NewValueKnown("http_method") && d.NewValueKnown("request_body") {
+		// CustomizeDiff can't return warnings, so log them at plan time too (they're returned again on apply).
+		for _, w := range monitorRequestBodyWarnings(d.Get("http_method").(string), d.Get("request_body").(string)) {
+			log.Printf("[WARN] %s: %s", w.Summary, w.Detail)
+		}
 	}
+	if !d.NewValueKnown("monitor_type") {
+		return nil
+	}
+	switch monitorType := d.Get("monitor_type").(string); monitorType {
+	case "keyword", "keyword_absence", "udp":
+		if d.NewValueKnown("required_keyword") && d.Get("required_keyword").(string) == "" {
+			return fmt.Errorf("required_keyword must be set when monitor_type is %q", monitorType)
+		}
+	case "javascript":
+		if d.NewValueKnown("javascript") && d.Get("javascript").(string) == "" {
+			return fmt.Errorf("javascript must be set when monitor_type is %q", monitorType)
+		}
+	case "multihttp":
+		if d.NewValueKnown("step") && len(d.Get("step").([]interface{})) == 0 {
+			return fmt.Errorf("at least one step must be set when monitor_type is %q", monitorType)
+		}
+	case "expected_status_code":
+		if d.NewValueKnown("expected_status_codes") && len(d.Get("expected_status_codes").([]interface{})) == 0 {
+			return fmt.Errorf("expected_status_codes must be set when monitor_type is %q", monitorType)
+		}
+	}
+	return nil
+}
+
+// monitorCheckPronounceableName fails the plan when another monitor in the team already has the pronounceable name,
+// which Better Uptime would otherwise only reject on apply.
+func monitorCheckPronounceableName(ctx context.Context, d *schema.ResourceDiff, meta interface{}, name string) error {
+	var teamName *string
+	if v := d.Get("team_name").(string); v != "" {
+		teamName = &v
+	}
+	teamName = defaultTeamName(meta, teamName)
+	var taken []string
+	derr := fetchAll(ctx, meta, "/api/v2/monitors?page=1", func(id string, attributes json.RawMessage) error {
+		var in monitor
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return err
+		}
+		if id == d.Id() || in.PronounceableName == nil || *in.PronounceableName != name {
+			return nil
+		}
+		// Global tokens list the monitors of every team, so only count those known to be in the same team.
+		if teamName != nil && (in.TeamName == nil || !strings.EqualFold(*in.TeamName, *teamName)) {
+			return nil
+		}
+		taken = append(taken, id)
+		return nil
+	})
+	if derr.HasError() {
+		return fmt.Errorf("failed to check whether pronounceable_name is taken: %s", derr[0].Summary)
+	}
+	if len(taken) != 0 {
+		return fmt.Errorf("pronounceable_name %q is already used by monitor %s, but must be unique within the team. Add a suffix (e.g. %q) to tell monitors apart", name, strings.Join(taken, ", "), name+" (EU)")
+	}
+	return nil
 }
 
 type monitor struct {
-	SSLExpiration      *int      `json:"ssl_expiration,omitempty"`
-	PolicyID           *string   `json:"policy_id,omitempty"`
-	URL                *string   `json:"url,omitempty"`
-	MonitorType        *string   `json:"monitor_type,omitempty"`
-	RequiredKeyword    *string   `json:"required_keyword,omitempty"`
-	Call               *bool     `json:"call,omitempty"`
-	SMS                *bool     `json:"sms,omitempty"`
-	Email              *bool     `json:"email,omitempty"`
-	Push               *bool     `json:"push,omitempty"`
-	TeamWait           *int      `json:"team_wait,omitempty"`
-	Paused             *bool     `json:"paused,omitempty"`
-	Port               *string   `json:"port,omitempty"`
-	Regions            *[]string `json:"regions,omitempty"`
-	MonitorGroupID     *int      `json:"monitor_group_id,omitempty"`
-	PronounceableName  *string   `json:"pronounceable_name,omitempty"`
-	RecoveryPeriod     *int      `json:"recovery_period,omitempty"`
-	VerifySSL          *bool     `json:"verify_ssl,omitempty"`
-	CheckFrequency     *int      `json:"check_frequency,omitempty"`
-	ConfirmationPeriod *int      `json:"confirmation_period,omitempty"`
-	HTTPMethod         *string   `json:"http_method,omitempty"`
-	RequestTimeout     *int      `json:"request_timeout,omitempty"`
-	RequestBody        *string   `json:"request_body,omitempty"`
-	AuthUsername       *string   `json:"auth_username,omitempty"`
-	AuthPassword       *string   `json:"auth_password,omitempty"`
-	MaintenanceFrom    *string   `json:"maintenance_from,omitempty"`
-	MaintenanceTo      *string   `json:"maintenance_to,omitempty"`
+	SSLExpiration                 *int                    `json:"ssl_expiration,omitempty"`
+	DomainExpiration              *int                    `json:"domain_expiration,omitempty"`
+	PolicyID                      *string                 `json:"policy_id,omitempty"`
+	EscalationPolicyID            *string                 `json:"escalation_policy_id,omitempty"`
+	OnCallIntegrationID           *string                 `json:"on_call_integration_id,omitempty"`
+	TeamName                      *string                 `json:"team_name,omitempty"`
+	URL                           *string                 `json:"url,omitempty"`
+	MonitorType                   *string                 `json:"monitor_type,omitempty"`
+	RequiredKeyword               *string                 `json:"required_keyword,omitempty"`
+	Javascript                    *string                 `json:"javascript,omitempty"`
+	AlertEmailSubject             *string                 `json:"alert_email_subject,omitempty"`
+	RecoveryEmailSubject          *string                 `json:"recovery_email_subject,omitempty"`
+	CreateIncident                *bool                   `json:"create_incident,omitempty"`
+	Call                          *bool                   `json:"call,omitempty"`
+	SMS                           *bool                   `json:"sms,omitempty"`
+	Email                         *bool                   `json:"email,omitempty"`
+	Push                          *bool                   `json:"push,omitempty"`
+	SMSVerification               *bool                   `json:"sms_verification,omitempty"`
+	TeamMemberIDsForNotifications *[]string               `json:"team_member_ids_for_notifications,omitempty"`
+	TeamWait                      *int                    `json:"team_wait,omitempty"`
+	Paused                        *bool                   `json:"paused,omitempty"`
+	PausedAt                      *string                 `json:"paused_at,omitempty"`
+	Status                        *string                 `json:"status,omitempty"`
+	LastCheckedAt                 *string                 `json:"last_checked_at,omitempty"`
+	LastIncidentID                *string                 `json:"last_incident_id,omitempty"`
+	CreatedAt                     *string                 `json:"created_at,omitempty"`
+	UpdatedAt                     *string                 `json:"updated_at,omitempty"`
+	Port                          *string                 `json:"port,omitempty"`
+	Regions                       *[]string               `json:"regions,omitempty"`
+	SplitSignal                   *bool                   `json:"split_signal,omitempty"`
+	Tags                          *[]string               `json:"tags,omitempty"`
+	MonitorGroupID                *int                    `json:"monitor_group_id,omitempty"`
+	PronounceableName             *string                 `json:"pronounceable_name,omitempty"`
+	IncidentPrefix                *string                 `json:"incident_prefix,omitempty"`
+	RecoveryPeriod                *int                    `json:"recovery_period,omitempty"`
+	VerifySSL                     *bool                   `json:"verify_ssl,omitempty"`
+	FollowRedirects               *bool                   `json:"follow_redirects,omitempty"`
+	Screenshot                    *bool                   `json:"screenshot,omitempty"`
+	CheckFrequency                *int                    `json:"check_frequency,omitempty"`
+	ConfirmationPeriod            *int                    `json:"confirmation_period,omitempty"`
+	HTTPMethod                    *string                 `json:"http_method,omitempty"`
+	RequestTimeout                *int                    `json:"request_timeout,omitempty"`
+	TCPTimeout                    *int                    `json:"tcp_timeout,omitempty"`
+	UDPTimeout                    *int                    `json:"udp_timeout,omitempty"`
+	ResponseTimeThreshold         *int                    `json:"response_time_threshold,omitempty"`
+	AvailabilityThreshold         *float64                `json:"availability_threshold,omitempty"`
+	ExpectedStatusCodes           *[]int                  `json:"expected_status_codes,omitempty"`
+	RequestHeaders                *[]monitorRequestHeader `json:"request_headers,omitempty"`
+	RequestBody                   *string                 `json:"request_body,omitempty"`
+	BasicAuthUsername             *string                 `json:"basic_auth_username,omitempty"`
+	BasicAuthPassword             *string                 `json:"basic_auth_password,omitempty"`
+	AuthUsername                  *string                 `json:"auth_username,omitempty"`
+	AuthPassword                  *string                 `json:"auth_password,omitempty"`
+	MaintenanceFrom               *string                 `json:"maintenance_from,omitempty"`
+	MaintenanceTo                 *string                 `json:"maintenance_to,omitempty"`
+	MaintenanceDays               *[]int                  `json:"maintenance_days,omitempty"`
+	MaintenanceTimezone           *string                 `json:"maintenance_timezone,omitempty"`
+	MultihttpSteps                *[]monitorStep          `json:"multihttp_steps,omitempty"`
+}
+
+type monitorStep struct {
+	URL            string                 `json:"url"`
+	Method         string                 `json:"method"`
+	RequestHeaders []monitorRequestHeader `json:"request_headers"`
+	RequestBody    string                 `json:"request_body,omitempty"`
+	Assertions     []monitorStepAssertion `json:"assertions"`
+}
+
+type monitorStepAssertion struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type monitorRequestHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 type monitorHTTPResponse struct {
@@ -276,32 +818,134 @@ func monitorRef(in *monitor) []struct {
 		v interface{}
 	}{
 		{k: "ssl_expiration", v: &in.SSLExpiration},
+		{k: "domain_expiration", v: &in.DomainExpiration},
 		{k: "policy_id", v: &in.PolicyID},
+		{k: "escalation_policy_id", v: &in.EscalationPolicyID},
+		{k: "on_call_integration_id", v: &in.OnCallIntegrationID},
+		{k: "team_name", v: &in.TeamName},
 		{k: "url", v: &in.URL},
 		{k: "monitor_type", v: &in.MonitorType},
 		{k: "required_keyword", v: &in.RequiredKeyword},
+		{k: "javascript", v: &in.Javascript},
+		{k: "alert_email_subject", v: &in.AlertEmailSubject},
+		{k: "recovery_email_subject", v: &in.RecoveryEmailSubject},
+		{k: "create_incident", v: &in.CreateIncident},
 		{k: "call", v: &in.Call},
 		{k: "sms", v: &in.SMS},
 		{k: "email", v: &in.Email},
 		{k: "push", v: &in.Push},
+		{k: "sms_verification", v: &in.SMSVerification},
+		{k: "team_member_ids_for_notifications", v: &in.TeamMemberIDsForNotifications},
 		{k: "team_wait", v: &in.TeamWait},
 		{k: "paused", v: &in.Paused},
+		{k: "paused_at", v: &in.PausedAt},
+		{k: "status", v: &in.Status},
+		{k: "last_checked_at", v: &in.LastCheckedAt},
+		{k: "last_incident_id", v: &in.LastIncidentID},
+		{k: "created_at", v: &in.CreatedAt},
+		{k: "updated_at", v: &in.UpdatedAt},
 		{k: "port", v: &in.Port},
 		{k: "regions", v: &in.Regions},
+		{k: "split_signal", v: &in.SplitSignal},
+		{k: "tags", v: &in.Tags},
 		{k: "monitor_group_id", v: &in.MonitorGroupID},
 		{k: "pronounceable_name", v: &in.PronounceableName},
+		{k: "incident_prefix", v: &in.IncidentPrefix},
 		{k: "recovery_period", v: &in.RecoveryPeriod},
 		{k: "verify_ssl", v: &in.VerifySSL},
+		{k: "follow_redirects", v: &in.FollowRedirects},
+		{k: "screenshot", v: &in.Screenshot},
 		{k: "check_frequency", v: &in.CheckFrequency},
 		{k: "confirmation_period", v: &in.ConfirmationPeriod},
 		{k: "http_method", v: &in.HTTPMethod},
 		{k: "request_timeout", v: &in.RequestTimeout},
+		{k: "tcp_timeout", v: &in.TCPTimeout},
+		{k: "udp_timeout", v: &in.UDPTimeout},
+		{k: "response_time_threshold", v: &in.ResponseTimeThreshold},
+		{k: "availability_threshold", v: &in.AvailabilityThreshold},
+		{k: "expected_status_codes", v: &in.ExpectedStatusCodes},
 		{k: "request_body", v: &in.RequestBody},
+		{k: "basic_auth_username", v: &in.BasicAuthUsername},
+		{k: "basic_auth_password", v: &in.BasicAuthPassword},
 		{k: "auth_username", v: &in.AuthUsername},
 		{k: "auth_password", v: &in.AuthPassword},
 		{k: "maintenance_from", v: &in.MaintenanceFrom},
 		{k: "maintenance_to", v: &in.MaintenanceTo},
+		{k: "maintenance_days", v: &in.MaintenanceDays},
+		{k: "maintenance_timezone", v: &in.MaintenanceTimezone},
+	}
+}
+
+func monitorLoadRequestHeaders(d *schema.ResourceData, in *monitor) {
+	// Send an empty list (rather than nothing) when all headers are removed.
+	headers := monitorRequestHeaders(d.Get("request_headers").(map[string]interface{}))
+	in.RequestHeaders = &headers
+}
+
+// monitorRequestHeaders converts a map of header names to values to a list sorted by name.
+func monitorRequestHeaders(m map[string]interface{}) []monitorRequestHeader {
+	headers := []monitorRequestHeader{}
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		headers = append(headers, monitorRequestHeader{Name: name, Value: m[name].(string)})
 	}
+	return headers
+}
+
+func monitorLoadSteps(d *schema.ResourceData, in *monitor) {
+	steps := []monitorStep{}
+	for _, v := range d.Get("step").([]interface{}) {
+		m := v.(map[string]interface{})
+		assertions := []monitorStepAssertion{}
+		for _, a := range m["assertion"].([]interface{}) {
+			a := a.(map[string]interface{})
+			assertions = append(assertions, monitorStepAssertion{
+				Type:  a["type"].(string),
+				Value: a["value"].(string),
+			})
+		}
+		steps = append(steps, monitorStep{
+			URL:            m["url"].(string),
+			Method:         m["method"].(string),
+			RequestHeaders: monitorRequestHeaders(m["request_headers"].(map[string]interface{})),
+			RequestBody:    m["request_body"].(string),
+			Assertions:     assertions,
+		})
+	}
+	in.MultihttpSteps = &steps
+}
+
+// monitorFlattenSteps converts multihttp steps returned by Better Uptime to the "step" block.
+func monitorFlattenSteps(in *monitor) []interface{} {
+	var steps []interface{}
+	if in.MultihttpSteps == nil {
+		return steps
+	}
+	for _, s := range *in.MultihttpSteps {
+		headers := map[string]interface{}{}
+		for _, h := range s.RequestHeaders {
+			headers[h.Name] = h.Value
+		}
+		var assertions []interface{}
+		for _, a := range s.Assertions {
+			assertions = append(assertions, map[string]interface{}{
+				"type":  a.Type,
+				"value": a.Value,
+			})
+		}
+		steps = append(steps, map[string]interface{}{
+			"url":             s.URL,
+			"method":          s.Method,
+			"request_headers": headers,
+			"request_body":    s.RequestBody,
+			"assertion":       assertions,
+		})
+	}
+	return steps
 }
 
 func monitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -309,12 +953,17 @@ func monitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	for _, e := range monitorRef(&in) {
 		load(d, e.k, e.v)
 	}
+	monitorLoadRequestHeaders(d, &in)
+	if _, ok := d.GetOk("step"); ok {
+		monitorLoadSteps(d, &in)
+	}
+	in.TeamName = defaultTeamName(meta, in.TeamName)
 	var out monitorHTTPResponse
 	if err := resourceCreate(ctx, meta, "/api/v2/monitors", &in, &out); err != nil {
-		return err
+		return monitorPronounceableNameHint(err)
 	}
 	d.SetId(out.Data.ID)
-	return monitorCopyAttrs(d, &out.Data.Attributes)
+	return append(monitorCopyAttrs(d, &out.Data.Attributes), monitorWarnings(d)...)
 }
 
 func monitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -328,13 +977,70 @@ func monitorRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return monitorCopyAttrs(d, &out.Data.Attributes)
 }
 
+// monitorFormatTime reformats a timestamp returned by Better Uptime (e.g. "2021-01-01T00:00:00.000Z")
+// as RFC 3339 in UTC, leaving values it can't parse alone.
+func monitorFormatTime(in *string) *string {
+	if in == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *in)
+	if err != nil {
+		return in
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+// monitorFormatTimes applies monitorFormatTime to the monitor's check and audit timestamps.
+func monitorFormatTimes(in *monitor) {
+	in.LastCheckedAt = monitorFormatTime(in.LastCheckedAt)
+	in.CreatedAt = monitorFormatTime(in.CreatedAt)
+	in.UpdatedAt = monitorFormatTime(in.UpdatedAt)
+}
+
+// monitorNormalize fills in attributes older monitors don't report and formats timestamps consistently.
+func monitorNormalize(in *monitor) {
+	// Monitors created before follow_redirects was available don't report it, but do follow redirects.
+	if in.FollowRedirects == nil {
+		t := true
+		in.FollowRedirects = &t
+	}
+	// Likewise, monitors created before create_incident was available do open incidents.
+	if in.CreateIncident == nil {
+		t := true
+		in.CreateIncident = &t
+	}
+	monitorFormatTimes(in)
+}
+
 func monitorCopyAttrs(d *schema.ResourceData, in *monitor) diag.Diagnostics {
 	var derr diag.Diagnostics
+	// Better Uptime doesn't always return the team name, so keep the one we know about.
+	if in.TeamName == nil {
+		if v, ok := d.GetOk("team_name"); ok {
+			t := v.(string)
+			in.TeamName = &t
+		}
+	}
+	monitorNormalize(in)
 	for _, e := range monitorRef(in) {
 		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
 			derr = append(derr, diag.FromErr(err)[0])
 		}
 	}
+	// Headers are keyed by name, so the order returned by the API doesn't matter.
+	headers := map[string]interface{}{}
+	if in.RequestHeaders != nil {
+		for _, h := range *in.RequestHeaders {
+			headers[h.Name] = h.Value
+		}
+	}
+	if err := d.Set("request_headers", headers); err != nil {
+		derr = append(derr, diag.FromErr(err)[0])
+	}
+	if err := d.Set("step", monitorFlattenSteps(in)); err != nil {
+		derr = append(derr, diag.FromErr(err)[0])
+	}
 	return derr
 }
 
@@ -345,7 +1051,54 @@ func monitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}
 			load(d, e.k, e.v)
 		}
 	}
-	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/monitors/%s", url.PathEscape(d.Id())), &in)
+	if d.HasChange("request_headers") {
+		monitorLoadRequestHeaders(d, &in)
+	}
+	if d.HasChange("step") {
+		monitorLoadSteps(d, &in)
+	}
+	if d.HasChange("regions") && in.Regions == nil {
+		// Send an empty list (rather than nothing) so that Better Uptime falls back to the default regions.
+		in.Regions = &[]string{}
+	}
+	if d.HasChange("team_member_ids_for_notifications") && in.TeamMemberIDsForNotifications == nil {
+		// Likewise, an empty list makes Better Uptime notify according to the escalation policy again.
+		in.TeamMemberIDsForNotifications = &[]string{}
+	}
+	if derr := resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/monitors/%s", url.PathEscape(d.Id())), &in); derr != nil {
+		return monitorPronounceableNameHint(derr)
+	}
+	// Refresh computed attributes (e.g. paused_at) that may have changed as a result of the update.
+	return append(monitorRead(ctx, d, meta), monitorWarnings(d)...)
+}
+
+func monitorWarnings(d *schema.ResourceData) diag.Diagnostics {
+	return monitorRequestBodyWarnings(d.Get("http_method").(string), d.Get("request_body").(string))
+}
+
+// monitorRequestBodyWarnings warns that request_body isn't sent with GET and HEAD requests.
+func monitorRequestBodyWarnings(method, body string) diag.Diagnostics {
+	var derr diag.Diagnostics
+	method = strings.ToUpper(method)
+	if body != "" && (method == "GET" || method == "HEAD") {
+		derr = append(derr, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       `"request_body" is ignored`,
+			Detail:        fmt.Sprintf("Request body is only sent with POST, PUT and PATCH requests, but http_method is %s.", method),
+			AttributePath: cty.Path{cty.GetAttrStep{Name: "request_body"}},
+		})
+	}
+	return derr
+}
+
+// monitorPronounceableNameHint explains how to resolve API errors about pronounceable_name, which must be unique per team.
+func monitorPronounceableNameHint(derr diag.Diagnostics) diag.Diagnostics {
+	for i := range derr {
+		if strings.Contains(derr[i].Summary, "pronounceable_name") {
+			derr[i].Detail += "\n\npronounceable_name must be unique within the team. Add a suffix (e.g. \"Website (EU)\") to tell monitors apart."
+		}
+	}
+	return derr
 }
 
 func monitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
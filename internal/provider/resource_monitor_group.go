@@ -12,7 +12,7 @@ import (
 
 var monitorGroupSchema = map[string]*schema.Schema{
 	"id": {
-		Description: "The ID of this Monitor.",
+		Description: "The ID of this Monitor Group.",
 		Type:        schema.TypeString,
 		Computed:    true,
 	},
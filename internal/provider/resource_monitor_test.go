@@ -1,22 +1,35 @@
 package provider
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
 	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func TestResourceMonitor(t *testing.T) {
+// newMonitorServer is like newResourceServer, but mimics how Better Uptime treats monitors: it computes
+// attributes such as status and timestamps, omits attributes older monitors don't have and doesn't preserve
+// the order of lists. If creates isn't nil, it counts the monitors created.
+func newMonitorServer(t *testing.T, creates *int32) *httptest.Server {
 	var data atomic.Value
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Log("Received " + r.Method + " " + r.RequestURI)
 
 		if r.Header.Get("Authorization") != "Bearer foo" {
@@ -32,19 +45,51 @@ func TestResourceMonitor(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			data.Store(body)
-			w.WriteHeader(http.StatusCreated)
-			// Inject pronounceable_name.
+			// Inject attributes computed by Better Uptime.
 			computed := make(map[string]interface{})
 			if err := json.Unmarshal(body, &computed); err != nil {
 				t.Fatal(err)
 			}
 			computed["pronounceable_name"] = "computed_by_betteruptime"
+			computed["confirmation_period"] = 0
+			if _, ok := computed["policy_id"]; !ok {
+				computed["policy_id"] = "42"
+			}
+			computed["status"] = "pending"
+			computed["last_checked_at"] = "2021-01-01T01:02:03.456+01:00"
+			computed["created_at"] = "2021-01-01T00:00:00.000Z"
+			computed["updated_at"] = "2021-01-01T00:00:00.000Z"
+			if computed["paused"] == true {
+				computed["paused_at"] = "2021-01-01T00:00:00Z"
+				computed["status"] = "paused"
+			}
+			// Mimic a monitor created before follow_redirects and create_incident were available.
+			delete(computed, "follow_redirects")
+			delete(computed, "create_incident")
+			// Better Uptime doesn't preserve the order of request headers, regions or tags.
+			for _, k := range []string{"request_headers", "regions", "tags"} {
+				if list, ok := computed[k].([]interface{}); ok {
+					for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+						list[i], list[j] = list[j], list[i]
+					}
+				}
+			}
 			body, err = json.Marshal(computed)
 			if err != nil {
 				t.Fatal(err)
 			}
+			data.Store(body)
+			if creates != nil {
+				atomic.AddInt32(creates, 1)
+			}
+			w.WriteHeader(http.StatusCreated)
 			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"id":%q,"attributes":%s}}`, id, body)))
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			var items []string
+			if body, ok := data.Load().([]byte); ok && body != nil {
+				items = append(items, fmt.Sprintf(`{"id":%q,"attributes":%s}`, id, body))
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s],"pagination":{"next":null}}`, strings.Join(items, ","))))
 		case r.Method == http.MethodGet && r.RequestURI == prefix+"/"+id:
 			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"id":%q,"attributes":%s}}`, id, data.Load().([]byte))))
 		case r.Method == http.MethodPatch && r.RequestURI == prefix+"/"+id:
@@ -59,6 +104,11 @@ func TestResourceMonitor(t *testing.T) {
 			if err = json.Unmarshal(body, &patch); err != nil {
 				t.Fatal(err)
 			}
+			patch["updated_at"] = "2021-01-02T00:00:00.000Z"
+			if patch["paused"] != true {
+				delete(patch, "paused_at")
+				patch["status"] = "up"
+			}
 			patched, err := json.Marshal(patch)
 			if err != nil {
 				t.Fatal(err)
@@ -72,6 +122,10 @@ func TestResourceMonitor(t *testing.T) {
 			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
 		}
 	}))
+}
+
+func TestResourceMonitor(t *testing.T) {
+	server := newMonitorServer(t, nil)
 	defer server.Close()
 
 	var url = "http://example.com"
@@ -104,7 +158,16 @@ func TestResourceMonitor(t *testing.T) {
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "url", url),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", monitorType),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused_at", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "status", "paused"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "last_checked_at", "2021-01-01T00:02:03Z"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "created_at", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "updated_at", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "verify_ssl", "true"),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "pronounceable_name", "computed_by_betteruptime"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "confirmation_period", "0"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "policy_id", "42"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "create_incident", "true"),
 				),
 			},
 			// Step 2 - update.
@@ -125,7 +188,12 @@ func TestResourceMonitor(t *testing.T) {
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "url", url),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", monitorType),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused_at", ""),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "status", "up"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "created_at", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "updated_at", "2021-01-02T00:00:00Z"),
 					resource.TestCheckResourceAttr("betteruptime_monitor.this", "pronounceable_name", "override"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "regions.#", "0"),
 				),
 			},
 			// Step 3 - update (but preserve pronounceable_name).
@@ -173,3 +241,2263 @@ func TestResourceMonitor(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceMonitorCheckSettings(t *testing.T) {
+	server := newMonitorServer(t, nil)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url                     = "http://example.com"
+		monitor_type            = "status"
+		monitor_group_id        = 2
+		recovery_period         = 0
+		verify_ssl              = false
+		ssl_expiration          = 30
+		check_frequency         = 60
+		request_timeout         = 15
+		response_time_threshold = 3000
+		follow_redirects        = false
+		screenshot              = true
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url               = "http://example.com"
+					monitor_type      = "status"
+					domain_expiration = 14
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "domain_expiration", "14"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "verify_ssl", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "follow_redirects", "true"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_group_id", "2"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "recovery_period", "0"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "verify_ssl", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "ssl_expiration", "30"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "domain_expiration", "0"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "check_frequency", "60"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_timeout", "15"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "response_time_threshold", "3000"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "follow_redirects", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "screenshot", "true"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorRequest(t *testing.T) {
+	server := newMonitorServer(t, nil)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url                   = "http://example.com"
+		monitor_type          = "status"
+		http_method           = "POST"
+		expected_status_codes = [200, 301, 302]
+		request_body          = "{\"ping\":true}"
+		auth_username         = "user"
+		auth_password         = "pass"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                   = "http://example.com"
+					monitor_type          = "status"
+					expected_status_codes = [200, 201]
+					request_body          = "ignored"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.1", "201"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "http_method", "POST"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.#", "3"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.1", "301"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_body", `{"ping":true}`),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_username", "user"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_password", "pass"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorNotifications(t *testing.T) {
+	server := newMonitorServer(t, nil)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url                    = "http://example.com"
+		monitor_type           = "status"
+		create_incident        = false
+		alert_email_subject    = "Down: {{ url }}"
+		recovery_email_subject = "Recovered: {{url}}"
+		incident_prefix        = "[web]"
+		call                   = true
+		sms                    = false
+		push                   = false
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+					sms          = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "create_incident", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "sms", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "email", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "push", "true"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "create_incident", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "alert_email_subject", "Down: {{ url }}"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "recovery_email_subject", "Recovered: {{url}}"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "incident_prefix", "[web]"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "call", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "sms", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "email", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "push", "false"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorMaintenance(t *testing.T) {
+	var creates int32
+	server := newMonitorServer(t, &creates)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url                  = "http://example.com"
+		monitor_type         = "status"
+		maintenance_from     = "22:30"
+		maintenance_to       = "23:45:00"
+		maintenance_timezone = "Amsterdam"
+		maintenance_days     = [6]
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "http://example.com"
+					monitor_type     = "status"
+					maintenance_from = "01:00"
+					maintenance_to   = "03:00"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_from", "01:00"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_to", "03:00"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_timezone", "UTC"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_from", "22:30"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_to", "23:45:00"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_timezone", "Amsterdam"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_days.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "maintenance_days.0", "6"),
+					// Changing the maintenance window must update the monitor in place.
+					func(*terraform.State) error {
+						if n := atomic.LoadInt32(&creates); n != 1 {
+							return fmt.Errorf("expected the monitor to be created once, got %d", n)
+						}
+						return nil
+					},
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorUnorderedLists(t *testing.T) {
+	server := newMonitorServer(t, nil)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url          = "http://example.com"
+		monitor_type = "status"
+		regions      = ["us", "eu"]
+		tags         = ["web", "staging", "api"]
+		request_headers = {
+			"X-Api-Key" = "secret"
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+					regions      = ["us", "eu"]
+					tags         = ["production", "api"]
+					request_headers = {
+						"Authorization" = "Bearer secret"
+						"X-Request-Id"  = "terraform"
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "regions.*", "us"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "regions.*", "eu"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "tags.*", "api"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_headers.%", "2"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_headers.Authorization", "Bearer secret"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "tags.#", "3"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "tags.*", "staging"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_headers.%", "1"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_headers.X-Api-Key", "secret"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceMonitor(t *testing.T) {
+	// Monitors are created paused, so that they never alert anyone.
+	name := "tf-acc-" + acctest.RandString(8)
+	config := func(checkFrequency int) string {
+		return fmt.Sprintf(`
+		resource "betteruptime_monitor" "this" {
+			url                = "https://example.com/?%s"
+			monitor_type       = "status"
+			pronounceable_name = "%s"
+			check_frequency    = %d
+			paused             = true
+		}
+		`, name, name, checkFrequency)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config(180),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_monitor.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "pronounceable_name", name),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "check_frequency", "180"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused", "true"),
+					resource.TestCheckResourceAttrSet("betteruptime_monitor.this", "created_at"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config(300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "check_frequency", "300"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config(300),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_monitor.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Computed attributes that may change between the refresh and the import.
+				ImportStateVerifyIgnore: []string{"status", "last_checked_at", "updated_at"},
+			},
+		},
+	})
+}
+
+func TestResourceMonitorSensitive(t *testing.T) {
+	for _, k := range []string{"basic_auth_password", "auth_username", "auth_password", "javascript"} {
+		if !New().ResourcesMap["betteruptime_monitor"].Schema[k].Sensitive {
+			t.Errorf("%s must be marked as sensitive", k)
+		}
+	}
+}
+
+func TestResourceMonitorIncidentPrefix(t *testing.T) {
+	validate := New().ResourcesMap["betteruptime_monitor"].Schema["incident_prefix"].ValidateFunc
+	if _, errs := validate(strings.Repeat("x", 50), "incident_prefix"); len(errs) != 0 {
+		t.Errorf("50 characters must be accepted: %v", errs)
+	}
+	if _, errs := validate(strings.Repeat("x", 51), "incident_prefix"); len(errs) == 0 {
+		t.Error("51 characters must be rejected")
+	}
+}
+
+func TestResourceMonitorEmailSubject(t *testing.T) {
+	for _, k := range []string{"alert_email_subject", "recovery_email_subject"} {
+		validate := New().ResourcesMap["betteruptime_monitor"].Schema[k].ValidateFunc
+		for _, tc := range []struct {
+			subject string
+			valid   bool
+		}{
+			{"Monitor is down", true},
+			{"{{url}} is down", true},
+			{"{{ pronounceable_name }} ({{url}})", true},
+			{"{{monitor_type}} monitor is down: {{cause}}", true},
+			{"{{foo}} is down", false},
+			{"{{url}} is down since {{started_at}}", false},
+			{"", false},
+			{"{{url is down", false},
+			{"url}} is down", false},
+			{"{{URL}} is down", false},
+			{"{{url-name}} is down", false},
+		} {
+			if _, errs := validate(tc.subject, k); (len(errs) == 0) != tc.valid {
+				t.Errorf("%s %q: got errors %v, want valid=%v", k, tc.subject, errs, tc.valid)
+			}
+		}
+	}
+}
+
+func TestResourceMonitorRegions(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(regions string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "https://example.com"
+			monitor_type = "status"
+			regions      = %s
+		}
+		`, regions)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject unknown region.
+			{
+				Config:      config(`["US", "SA"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected regions.\d+ to be one of \[us eu as au\], got SA`),
+			},
+			// Step 2 - create.
+			{
+				Config: config(`["US", "EU"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "regions.*", "us"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "regions.*", "eu"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config(`["US", "EU"]`),
+				PlanOnly: true,
+			},
+			// Step 4 - change only the case, check plan is empty.
+			{
+				Config:   config(`["us", "Eu"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorHTTPMethod(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(method string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "https://example.com"
+			monitor_type = "status"
+			http_method  = "%s"
+		}
+		`, method)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject unsupported method at plan time.
+			{
+				Config:      config("CONNECT"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected http_method to be one of \[GET POST PUT PATCH DELETE HEAD\s+OPTIONS\], got CONNECT`),
+			},
+			// Step 2 - create (method is case-insensitive).
+			{
+				Config: config("delete"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "http_method", "delete"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config("DELETE"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorRequestBodyWarning(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	// The provider runs in-process, so its plan-time warnings end up in the standard logger.
+	var logs bytes.Buffer
+	captureLogs := func() {
+		logs.Reset()
+		log.SetOutput(&logs)
+	}
+	defer log.SetOutput(os.Stderr)
+	warning := `[WARN] "request_body" is ignored: Request body is only sent with POST, PUT and PATCH requests, but http_method is GET.`
+
+	config := func(method string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "http://example.com"
+			monitor_type = "status"
+			http_method  = "%s"
+			request_body = "{\"ping\":true}"
+		}
+		`, method)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create, check the plan warns that request_body is ignored.
+			{
+				PreConfig: captureLogs,
+				Config:    config("GET"),
+				Check: func(*terraform.State) error {
+					if !strings.Contains(logs.String(), warning) {
+						return fmt.Errorf("expected plan to log %q", warning)
+					}
+					return nil
+				},
+			},
+			// Step 2 - update, check the plan doesn't warn once request_body is sent.
+			{
+				PreConfig: captureLogs,
+				Config:    config("POST"),
+				Check: func(*terraform.State) error {
+					if strings.Contains(logs.String(), `"request_body" is ignored`) {
+						return fmt.Errorf("unexpected warning for http_method POST")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceMonitorType(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject unknown monitor type.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "example.com"
+					monitor_type = "telnet"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Expected one of \[status\s+expected_status_code\s+keyword\s+keyword_absence\s+javascript\s+multihttp\s+ping\s+tcp\s+udp\s+smtp\s+pop\s+imap\],\s+got\s+"telnet"`),
+			},
+		},
+	})
+}
+
+func TestResourceMonitorPort(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject out of range port.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "example.com"
+					monitor_type = "tcp"
+					port         = "70000"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid "port"`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "example.com"
+					monitor_type = "tcp"
+					port         = "8080"
+					tcp_timeout  = 10
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "port", "8080"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "tcp_timeout", "10"),
+				),
+			},
+			// Step 3 - update.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "example.com"
+					monitor_type = "smtp"
+					port         = "25,465,587"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "port", "25,465,587"),
+				),
+			},
+			// Step 4 - make no changes to an smtp monitor (udp_timeout is ignored), check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "example.com"
+					monitor_type = "smtp"
+					port         = "25,465,587"
+					udp_timeout  = 5
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorKeyword(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject keyword monitor without required_keyword.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "keyword"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`required_keyword must be set when monitor_type is "keyword"`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "https://example.com"
+					monitor_type     = "keyword"
+					required_keyword = "Welcome"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", "keyword"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "required_keyword", "Welcome"),
+				),
+			},
+			// Step 3 - update to alert when the keyword is present.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "https://example.com"
+					monitor_type     = "keyword_absence"
+					required_keyword = "Internal Server Error"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", "keyword_absence"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "required_keyword", "Internal Server Error"),
+				),
+			},
+			// Step 4 - import with team name.
+			{
+				ResourceName:  "betteruptime_monitor.this",
+				ImportState:   true,
+				ImportStateId: "1/Platform",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if states[0].ID != "1" {
+						return fmt.Errorf("expected ID 1, got %s", states[0].ID)
+					}
+					if v := states[0].Attributes["team_name"]; v != "Platform" {
+						return fmt.Errorf("expected team_name Platform, got %q", v)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceMonitorExpectedStatusCode(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject expected_status_code monitor without expected_status_codes.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "expected_status_code"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`expected_status_codes must be set when monitor_type is "expected_status_code"`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                   = "https://example.com"
+					monitor_type          = "expected_status_code"
+					expected_status_codes = [401]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", "expected_status_code"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "expected_status_codes.0", "401"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceMonitorAuthorizationHeader(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(auth string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "https://example.com"
+			monitor_type = "status"
+			request_headers = {
+				authorization = "Bearer secret"
+			}
+			%s
+		}
+		`, auth)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject basic auth combined with an Authorization header.
+			{
+				Config:      config(`auth_username = "user"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`request_headers can't set "authorization" when auth_username is set`),
+			},
+			// Step 2 - reject HTTP Basic authentication combined with an Authorization header.
+			{
+				Config:      config(`basic_auth_password = "pass"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`request_headers can't set "authorization" when basic_auth_password is\s+set`),
+			},
+			// Step 3 - create.
+			{
+				Config: config(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "request_headers.authorization", "Bearer secret"),
+				),
+			},
+			// Step 4 - make no changes, check plan is empty.
+			{
+				Config:   config(""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorAuthScheme(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(auth string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "https://example.com"
+			monitor_type = "status"
+			%s
+		}
+		`, auth)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject an unknown scheme.
+			{
+				Config:      config(`auth_scheme = "ntlm"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected auth_scheme to be one of \[basic digest bearer\]`),
+			},
+			// Step 2 - reject a scheme without its credentials.
+			{
+				Config: config(`
+					auth_scheme   = "digest"
+					auth_password = "pass"
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`auth_username must be set when auth_scheme is "digest"`),
+			},
+			// Step 3 - reject credentials of another scheme.
+			{
+				Config: config(`
+					auth_scheme         = "basic"
+					basic_auth_username = "user"
+					auth_username       = "user"
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`auth_username can't be set when auth_scheme is "basic"`),
+			},
+			// Step 4 - reject a bearer scheme without an Authorization header.
+			{
+				Config:      config(`auth_scheme = "bearer"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`request_headers must set an Authorization header when auth_scheme is\s+"bearer"`),
+			},
+			// Step 5 - create.
+			{
+				Config: config(`
+					auth_scheme         = "basic"
+					basic_auth_username = "user"
+					basic_auth_password = "pass"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_scheme", "basic"),
+				),
+			},
+			// Step 6 - update.
+			{
+				Config: config(`
+					auth_scheme = "bearer"
+					request_headers = {
+						Authorization = "Bearer secret"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_scheme", "bearer"),
+				),
+			},
+			// Step 7 - make no changes, check plan is empty.
+			{
+				Config: config(`
+					auth_scheme = "bearer"
+					request_headers = {
+						Authorization = "Bearer secret"
+					}
+				`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorBasicAuth(t *testing.T) {
+	var sent map[string]interface{}
+	handler := newResourceHandler(t, "/api/v2/monitors", "1", nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	config := func(password string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url                 = "https://example.com"
+			monitor_type        = "status"
+			basic_auth_username = "user"
+			basic_auth_password = "%s"
+		}
+		`, password)
+	}
+
+	checkSent := func(password string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			if sent["basic_auth_password"] != password {
+				return fmt.Errorf("expected basic_auth_password %q to be sent, got %v", password, sent["basic_auth_password"])
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "basic_auth_username", "user"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "basic_auth_password", "one"),
+					checkSent("one"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config("two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "basic_auth_password", "two"),
+					checkSent("two"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config("two"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorDigestAuth(t *testing.T) {
+	var sent map[string]interface{}
+	handler := newResourceHandler(t, "/api/v2/monitors", "1", nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	// Digest and Basic authentication credentials can be set together.
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url                 = "https://example.com"
+		monitor_type        = "status"
+		auth_username       = "digest-user"
+		auth_password       = "digest-pass"
+		basic_auth_username = "basic-user"
+		basic_auth_password = "basic-pass"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_username", "digest-user"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "auth_password", "digest-pass"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "basic_auth_username", "basic-user"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "basic_auth_password", "basic-pass"),
+					func(s *terraform.State) error {
+						for k, v := range map[string]string{
+							"auth_username":       "digest-user",
+							"auth_password":       "digest-pass",
+							"basic_auth_username": "basic-user",
+							"basic_auth_password": "basic-pass",
+						} {
+							if sent[k] != v {
+								return fmt.Errorf("expected %s %q to be sent, got %v", k, v, sent[k])
+							}
+						}
+						return nil
+					},
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorJavascript(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject javascript monitor without javascript.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "javascript"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`javascript must be set when monitor_type is "javascript"`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "javascript"
+					javascript   = <<-EOT
+						const res = await fetch("https://example.com/health");
+						if (res.status !== 200) throw new Error("unhealthy");
+					EOT
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "monitor_type", "javascript"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "javascript",
+						"const res = await fetch(\"https://example.com/health\");\nif (res.status !== 200) throw new Error(\"unhealthy\");\n"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "javascript"
+					javascript   = <<-EOT
+						const res = await fetch("https://example.com/health");
+						if (res.status !== 200) throw new Error("unhealthy");
+					EOT
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorMultihttp(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject multihttp monitor without steps.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "multihttp"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`at least one step must be set when monitor_type is "multihttp"`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "multihttp"
+
+					step {
+						url    = "https://example.com/login"
+						method = "POST"
+						request_headers = {
+							"Content-Type" = "application/json"
+						}
+						request_body = "{\"user\":\"jane\"}"
+
+						assertion {
+							type  = "status_code"
+							value = "200"
+						}
+					}
+
+					step {
+						url = "https://example.com/dashboard"
+
+						assertion {
+							type  = "status_code"
+							value = "200"
+						}
+
+						assertion {
+							type  = "response_body"
+							value = "Welcome"
+						}
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.url", "https://example.com/login"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.method", "POST"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.request_headers.Content-Type", "application/json"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.request_body", `{"user":"jane"}`),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.assertion.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.1.method", "GET"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.1.assertion.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.1.assertion.1.type", "response_body"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.1.assertion.1.value", "Welcome"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "multihttp"
+
+					step {
+						url    = "https://example.com/login"
+						method = "POST"
+						request_headers = {
+							"Content-Type" = "application/json"
+						}
+						request_body = "{\"user\":\"jane\"}"
+
+						assertion {
+							type  = "status_code"
+							value = "200"
+						}
+					}
+
+					step {
+						url = "https://example.com/dashboard"
+
+						assertion {
+							type  = "status_code"
+							value = "200"
+						}
+
+						assertion {
+							type  = "response_body"
+							value = "Welcome"
+						}
+					}
+				}
+				`,
+				PlanOnly: true,
+			},
+			// Step 4 - update.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "multihttp"
+
+					step {
+						url = "https://example.com/health"
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.url", "https://example.com/health"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "step.0.assertion.#", "0"),
+				),
+			},
+			// Step 5 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "https://example.com"
+					monitor_type = "multihttp"
+
+					step {
+						url = "https://example.com/health"
+					}
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorPausedImport(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+					paused       = true
+				}
+				`,
+				Check: resource.TestCheckResourceAttr("betteruptime_monitor.this", "paused", "true"),
+			},
+			// Step 2 - import, check paused is read back.
+			{
+				ResourceName: "betteruptime_monitor.this",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if v := states[0].Attributes["paused"]; v != "true" {
+						return fmt.Errorf("expected paused true, got %q", v)
+					}
+					return nil
+				},
+				ImportStateVerify: true,
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+					paused       = true
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorPausedAtPlan(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"id":           "1",
+			"url":          "http://example.com",
+			"monitor_type": "status",
+			"paused":       "true",
+			"paused_at":    "2021-01-01T00:00:00Z",
+		},
+	}
+	for _, tc := range []struct {
+		paused   bool
+		computed bool
+	}{
+		{paused: false, computed: true}, // Resuming clears paused_at.
+		{paused: true, computed: false},
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"url":          "http://example.com",
+			"monitor_type": "status",
+			"paused":       tc.paused,
+		})
+		diff, err := newMonitorResource().SimpleDiff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		computed := diff != nil && diff.Attributes["paused_at"] != nil && diff.Attributes["paused_at"].NewComputed
+		if computed != tc.computed {
+			t.Errorf("paused = %t: got paused_at computed %t, want %t", tc.paused, computed, tc.computed)
+		}
+	}
+}
+
+func TestResourceMonitorSMSVerification(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "http://example.com"
+					monitor_type     = "status"
+					call             = true
+					sms_verification = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "sms_verification", "true"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "call", "true"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "http://example.com"
+					monitor_type     = "status"
+					call             = true
+					sms_verification = false
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "sms_verification", "false"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "call", "true"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url              = "http://example.com"
+					monitor_type     = "status"
+					call             = true
+					sms_verification = false
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorSplitSignal(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(splitSignal bool) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "http://example.com"
+			monitor_type = "status"
+			regions      = ["us", "eu"]
+			split_signal = %t
+		}
+		`, splitSignal)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "split_signal", "true"),
+				),
+			},
+			// Step 2 - make no changes, check import keeps split_signal.
+			{
+				ResourceName:      "betteruptime_monitor.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Step 3 - update.
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "split_signal", "false"),
+				),
+			},
+			// Step 4 - make no changes, check plan is empty.
+			{
+				Config:   config(false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorTeamName(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/monitors", "1")
+	defer server.Close()
+
+	config := func(teamName string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "http://example.com"
+			monitor_type = "status"
+			team_name    = %q
+		}
+		`, teamName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "team_name", "Platform"),
+				),
+			},
+			// Step 2 - change the case only, check plan is empty.
+			{
+				Config:   config("platform"),
+				PlanOnly: true,
+			},
+			// Step 3 - move to another team, check plan is not empty.
+			{
+				Config:             config("Infrastructure"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorPronounceableNameDuplicate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Method != http.MethodGet || r.RequestURI != "/api/v2/monitors?page=1" {
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"7","attributes":{"url":"http://example.net","pronounceable_name":"Website","team_name":"Platform"}},{"id":"8","attributes":{"url":"http://example.org","pronounceable_name":"Status","team_name":"Infrastructure"}}],"pagination":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	config := func(teamName, name string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url                = "http://example.com"
+			monitor_type       = "status"
+			team_name          = %q
+			pronounceable_name = %q
+		}
+		`, teamName, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject a name used by another monitor of the team.
+			{
+				Config:      config("platform", "Website"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)pronounceable_name "Website" is already used by monitor 7.*Add a suffix \(e.g.\s+"Website \(EU\)"\)`),
+			},
+			// Step 2 - accept a name used in another team only.
+			{
+				Config:             config("Platform", "Status"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorPronounceableNameTaken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		if r.Method == http.MethodGet && r.RequestURI == "/api/v2/monitors?page=1" {
+			// The name is taken by a monitor created after the plan.
+			_, _ = w.Write([]byte(`{"data":[],"pagination":{"next":null}}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":{"pronounceable_name":["has already been taken"]}}`))
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                = "http://example.com"
+					monitor_type       = "status"
+					pronounceable_name = "Website"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)pronounceable_name has already been taken.*Add a suffix`),
+			},
+		},
+	})
+}
+
+func TestResourceMonitorOnCallCalendar(t *testing.T) {
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/monitors":                    newResourceHandler(t, "/api/v2/monitors", "1", nil),
+		"/api/v2/on-call-calendars":           newResourceHandler(t, "/api/v2/on-call-calendars", "2", nil),
+		"/api/v2/on-call-calendars/2/members": newOnCallCalendarMembersHandler(t, "2"),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name = "primary"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "https://example.com"
+					monitor_type           = "status"
+					on_call_integration_id = betteruptime_on_call_calendar.this.id
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "on_call_integration_id", "2"),
+					resource.TestCheckResourceAttrPair("betteruptime_monitor.this", "on_call_integration_id", "betteruptime_on_call_calendar.this", "id"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name = "primary"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "https://example.com"
+					monitor_type           = "status"
+					on_call_integration_id = betteruptime_on_call_calendar.this.id
+				}
+				`,
+				PlanOnly: true,
+			},
+			// Step 3 - destroy.
+			{
+				ResourceName:      "betteruptime_monitor.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorEscalationPolicy(t *testing.T) {
+	var sent []interface{}
+	// Better Uptime assigns a policy_id even when escalation_policy_id is set.
+	monitors := newResourceHandler(t, "/api/v2/monitors", "1", map[string]interface{}{
+		"policy_id": "42",
+	})
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/monitors": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var in map[string]interface{}
+				if err := json.Unmarshal(body, &in); err != nil {
+					t.Fatal(err)
+				}
+				sent = append(sent, in["escalation_policy_id"])
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+			monitors.ServeHTTP(w, r)
+		}),
+		"/api/v2/escalation-policies": newResourceHandler(t, "/api/v2/escalation-policies", "2", nil),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(policy string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_escalation_policy" "this" {
+			name = "example"
+
+			step {
+				type      = "team"
+				target_id = 2
+			}
+		}
+
+		resource "betteruptime_monitor" "this" {
+			url          = "https://example.com"
+			monitor_type = "status"
+			%s
+		}
+		`, policy)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject both policy attributes.
+			{
+				Config: config(`
+					policy_id            = "3"
+					escalation_policy_id = "2"
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`policy_id can't be set together with escalation_policy_id`),
+			},
+			// Step 2 - create.
+			{
+				Config: config(`escalation_policy_id = betteruptime_escalation_policy.this.id`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "escalation_policy_id", "2"),
+					resource.TestCheckResourceAttrPair("betteruptime_monitor.this", "escalation_policy_id", "betteruptime_escalation_policy.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "policy_id", "42"),
+					func(s *terraform.State) error {
+						if len(sent) != 1 || sent[0] != "2" {
+							return fmt.Errorf("expected escalation_policy_id 2 to be sent, got %v", sent)
+						}
+						return nil
+					},
+				),
+			},
+			// Step 3 - make no changes, check plan is empty (the policy_id assigned by Better Uptime doesn't conflict).
+			{
+				Config:   config(`escalation_policy_id = betteruptime_escalation_policy.this.id`),
+				PlanOnly: true,
+			},
+			// Step 4 - reject changing policy_id while escalation_policy_id is set.
+			{
+				Config: config(`
+					policy_id            = "3"
+					escalation_policy_id = betteruptime_escalation_policy.this.id
+				`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`policy_id can't be set together with escalation_policy_id`),
+			},
+			// Step 5 - destroy.
+			{
+				ResourceName:      "betteruptime_monitor.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorNullStrings(t *testing.T) {
+	// Better Uptime may return null rather than "" (or no key at all) for optional strings that aren't set.
+	nulls := make(map[string]interface{})
+	for k, v := range monitorSchema {
+		if v.Type == schema.TypeString && v.Optional && !v.Computed && v.Default == nil && k != "url" {
+			nulls[k] = nil
+		}
+	}
+	server := newComputedResourceServer(t, "/api/v2/monitors", "1", nulls)
+	defer server.Close()
+
+	config := `
+	provider "betteruptime" {
+		api_token = "foo"
+	}
+
+	resource "betteruptime_monitor" "this" {
+		url          = "http://example.com"
+		monitor_type = "status"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "required_keyword", ""),
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "alert_email_subject", ""),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorLastIncidentID(t *testing.T) {
+	for _, tc := range []struct {
+		lastIncidentID interface{}
+		want           string
+	}{
+		// Better Uptime returns null for monitors that have never had an incident.
+		{nil, ""},
+		{"3", "3"},
+	} {
+		t.Run(fmt.Sprint(tc.lastIncidentID), func(t *testing.T) {
+			server := newComputedResourceServer(t, "/api/v2/monitors", "1", map[string]interface{}{
+				"last_incident_id": tc.lastIncidentID,
+			})
+			defer server.Close()
+
+			config := `
+			provider "betteruptime" {
+				api_token = "foo"
+			}
+
+			resource "betteruptime_monitor" "this" {
+				url          = "http://example.com"
+				monitor_type = "status"
+			}
+			`
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				ProviderFactories: map[string]func() (*schema.Provider, error){
+					"betteruptime": func() (*schema.Provider, error) {
+						return New(WithURL(server.URL)), nil
+					},
+				},
+				Steps: []resource.TestStep{
+					// Step 1 - create.
+					{
+						Config: config,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("betteruptime_monitor.this", "last_incident_id", tc.want),
+						),
+					},
+					// Step 2 - make no changes, check plan is empty.
+					{
+						Config:   config,
+						PlanOnly: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceMonitorTeamMemberIDsForNotifications(t *testing.T) {
+	// Better Uptime returns an empty list rather than null when no team members are set.
+	server := newComputedResourceServer(t, "/api/v2/monitors", "1", map[string]interface{}{
+		"team_member_ids_for_notifications": []string{},
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "team_member_ids_for_notifications.#", "0"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+				}
+				`,
+				PlanOnly: true,
+			},
+			// Step 3 - update.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                               = "http://example.com"
+					monitor_type                      = "status"
+					team_member_ids_for_notifications = ["123", "456"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "team_member_ids_for_notifications.#", "2"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "team_member_ids_for_notifications.*", "123"),
+					resource.TestCheckTypeSetElemAttr("betteruptime_monitor.this", "team_member_ids_for_notifications.*", "456"),
+				),
+			},
+			// Step 4 - update (clear).
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                               = "http://example.com"
+					monitor_type                      = "status"
+					team_member_ids_for_notifications = []
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "team_member_ids_for_notifications.#", "0"),
+				),
+			},
+			// Step 5 - make no changes (null instead of empty), check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url          = "http://example.com"
+					monitor_type = "status"
+				}
+				`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceMonitorAvailabilityThreshold(t *testing.T) {
+	// Mimic Better Uptime returning the threshold as a fixed-scale decimal.
+	server := newComputedResourceServer(t, "/api/v2/monitors", "1", map[string]interface{}{
+		"availability_threshold": json.Number("99.900"),
+	})
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject out of range threshold.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "http://example.com"
+					monitor_type           = "status"
+					availability_threshold = 100.5
+				}
+				`,
+				ExpectError: regexp.MustCompile(`expected availability_threshold to be in the range \(0\.0+ - 100\.0+\)`),
+			},
+			// Step 2 - create.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "http://example.com"
+					monitor_type           = "status"
+					availability_threshold = 99.9
+				}
+				`,
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "http://example.com"
+					monitor_type           = "status"
+					availability_threshold = 99.9
+				}
+				`,
+				PlanOnly: true,
+			},
+			// Step 4 - update.
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor" "this" {
+					url                    = "http://example.com"
+					monitor_type           = "status"
+					availability_threshold = 95.5
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_monitor.this", "availability_threshold", "95.5"),
+				),
+			},
+		},
+	})
+}
+
+var updateMonitorSchema = flag.Bool("update-monitor-schema", false, "update testdata/monitor_schema.json")
+
+// TestResourceMonitorSchema pins the betteruptime_monitor schema as Terraform sees it, so that the same HCL keeps
+// producing the same plan when the resource is reimplemented (e.g. on terraform-plugin-framework).
+// Run with -update-monitor-schema after an intentional schema change.
+func TestResourceMonitorSchema(t *testing.T) {
+	resp, err := schema.NewGRPCProviderServer(New()).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.MarshalIndent(resp.ResourceSchemas["betteruptime_monitor"], "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+	if *updateMonitorSchema {
+		if err := ioutil.WriteFile("testdata/monitor_schema.json", got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile("testdata/monitor_schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("betteruptime_monitor schema doesn't match testdata/monitor_schema.json (run with -update-monitor-schema if the change is intended):\n%s", got)
+	}
+}
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var onCallCalendarMemberOverrideSchema = map[string]*schema.Schema{
+	"from": {
+		Description:      "When the override starts (RFC 3339).",
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: onCallCalendarSuppressEquivalentTime,
+	},
+	"to": {
+		Description:      "When the override ends (RFC 3339).",
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: onCallCalendarSuppressEquivalentTime,
+	},
+}
+
+var onCallCalendarMemberSchema = map[string]*schema.Schema{
+	"team_member_id": {
+		Description: "The ID of the team member who is on-call.",
+		Type:        schema.TypeInt,
+		Required:    true,
+	},
+	"position": {
+		Description: "The position of this member in the on-call rotation, indexed from zero. Defaults to the index of the `member` block.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	},
+	"weekdays": {
+		Description: "Days of the week this member is on-call (0 = Monday, 6 = Sunday, as for `betteruptime_monitor.maintenance_days`). Leave blank for every day.",
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type:         schema.TypeInt,
+			ValidateFunc: validation.IntBetween(0, 6),
+		},
+		Optional: true,
+	},
+	"from_hour": {
+		Description:  "Hour of the day (in the calendar's time zone) the on-call shift starts.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntBetween(0, 23),
+	},
+	"to_hour": {
+		Description:  "Hour of the day (in the calendar's time zone) the on-call shift ends.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      24,
+		ValidateFunc: validation.IntBetween(1, 24),
+	},
+	"override": {
+		Description: "A date range during which this member is on-call regardless of the rotation (e.g. to cover for a colleague).",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: onCallCalendarMemberOverrideSchema,
+		},
+	},
+}
+
+var onCallCalendarSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this On-call Calendar.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the on-call calendar that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"time_zone": {
+		Description: "What timezone should we use for the on-call calendar? The accepted values can be found in the Rails TimeZone documentation. https://api.rubyonrails.org/classes/ActiveSupport/TimeZone.html",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"member": {
+		Description: "A member of the on-call rotation (managed via the calendar's members endpoint).",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: onCallCalendarMemberSchema,
+		},
+	},
+}
+
+func newOnCallCalendarResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: onCallCalendarCreate,
+		ReadContext:   onCallCalendarRead,
+		UpdateContext: onCallCalendarUpdate,
+		DeleteContext: onCallCalendarDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/on-call-calendars-api",
+		Schema:      onCallCalendarSchema,
+	}
+}
+
+type onCallCalendarMemberOverride struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type onCallCalendarMember struct {
+	TeamMemberID int                            `json:"team_member_id"`
+	Position     int                            `json:"position"`
+	Weekdays     []int                          `json:"weekdays,omitempty"`
+	FromHour     int                            `json:"from_hour"`
+	ToHour       int                            `json:"to_hour"`
+	Overrides    []onCallCalendarMemberOverride `json:"overrides,omitempty"`
+}
+
+type onCallCalendar struct {
+	Name     *string `json:"name,omitempty"`
+	TimeZone *string `json:"time_zone,omitempty"`
+}
+
+type onCallCalendarHTTPResponse struct {
+	Data struct {
+		ID         string         `json:"id"`
+		Attributes onCallCalendar `json:"attributes"`
+	} `json:"data"`
+}
+
+func onCallCalendarRef(in *onCallCalendar) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "time_zone", v: &in.TimeZone},
+	}
+}
+
+type onCallCalendarMemberHTTPResponse struct {
+	Data struct {
+		ID         string               `json:"id"`
+		Attributes onCallCalendarMember `json:"attributes"`
+	} `json:"data"`
+}
+
+// onCallCalendarFormatTime reformats an override time returned by Better Uptime like monitorFormatTime.
+func onCallCalendarFormatTime(s string) string {
+	return *monitorFormatTime(&s)
+}
+
+// onCallCalendarSuppressEquivalentTime ignores differences in how the same override time is written
+// (e.g. "2021-12-24T00:00:00Z" and "2021-12-24T01:00:00+01:00").
+func onCallCalendarSuppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
+}
+
+func onCallCalendarLoadMembers(d *schema.ResourceData) []onCallCalendarMember {
+	members := []onCallCalendarMember{}
+	for i, v := range d.Get("member").([]interface{}) {
+		m := v.(map[string]interface{})
+		position := i
+		if v, ok := d.GetOk(fmt.Sprintf("member.%d.position", i)); ok {
+			position = v.(int)
+		}
+		var weekdays []int
+		for _, w := range m["weekdays"].([]interface{}) {
+			weekdays = append(weekdays, w.(int))
+		}
+		var overrides []onCallCalendarMemberOverride
+		for _, o := range m["override"].([]interface{}) {
+			o := o.(map[string]interface{})
+			overrides = append(overrides, onCallCalendarMemberOverride{
+				From: o["from"].(string),
+				To:   o["to"].(string),
+			})
+		}
+		members = append(members, onCallCalendarMember{
+			TeamMemberID: m["team_member_id"].(int),
+			Position:     position,
+			Weekdays:     weekdays,
+			FromHour:     m["from_hour"].(int),
+			ToHour:       m["to_hour"].(int),
+			Overrides:    overrides,
+		})
+	}
+	return members
+}
+
+func onCallCalendarMembersURL(id string) string {
+	return fmt.Sprintf("/api/v2/on-call-calendars/%s/members", url.PathEscape(id))
+}
+
+// onCallCalendarFetchMembers returns the members of an on-call calendar (and their IDs) in rotation order.
+func onCallCalendarFetchMembers(ctx context.Context, meta interface{}, id string) (ids []string, members []onCallCalendarMember, derr diag.Diagnostics) {
+	derr = fetchAll(ctx, meta, onCallCalendarMembersURL(id)+"?page=1", func(id string, attributes json.RawMessage) error {
+		var in onCallCalendarMember
+		if err := json.Unmarshal(attributes, &in); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+		members = append(members, in)
+		return nil
+	})
+	sort.Stable(onCallCalendarMembersByPosition{ids, members})
+	return ids, members, derr
+}
+
+type onCallCalendarMembersByPosition struct {
+	ids     []string
+	members []onCallCalendarMember
+}
+
+func (s onCallCalendarMembersByPosition) Len() int {
+	return len(s.members)
+}
+
+func (s onCallCalendarMembersByPosition) Less(i, j int) bool {
+	return s.members[i].Position < s.members[j].Position
+}
+
+func (s onCallCalendarMembersByPosition) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.members[i], s.members[j] = s.members[j], s.members[i]
+}
+
+// onCallCalendarSyncMembers makes the calendar's members match the member blocks: existing members are matched
+// by team_member_id and updated, the rest are added, and members without a block are removed.
+func onCallCalendarSyncMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids, existing, err := onCallCalendarFetchMembers(ctx, meta, d.Id())
+	if err != nil {
+		return err
+	}
+	// The same team member may be on-call more than once (e.g. on different weekdays).
+	byTeamMember := make(map[int][]string)
+	for i, m := range existing {
+		byTeamMember[m.TeamMemberID] = append(byTeamMember[m.TeamMemberID], ids[i])
+	}
+	matched := make(map[string]bool)
+	members := onCallCalendarLoadMembers(d)
+	for i := range members {
+		if ids := byTeamMember[members[i].TeamMemberID]; len(ids) != 0 {
+			byTeamMember[members[i].TeamMemberID] = ids[1:]
+			matched[ids[0]] = true
+			if err := resourceUpdate(ctx, meta, fmt.Sprintf("%s/%s", onCallCalendarMembersURL(d.Id()), url.PathEscape(ids[0])), &members[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		var out onCallCalendarMemberHTTPResponse
+		if err := resourceCreate(ctx, meta, onCallCalendarMembersURL(d.Id()), &members[i], &out); err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		if matched[id] {
+			continue
+		}
+		if err := resourceDelete(ctx, meta, fmt.Sprintf("%s/%s", onCallCalendarMembersURL(d.Id()), url.PathEscape(id))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func onCallCalendarCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in onCallCalendar
+	for _, e := range onCallCalendarRef(&in) {
+		load(d, e.k, e.v)
+	}
+	var out onCallCalendarHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/on-call-calendars", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	if err := onCallCalendarSyncMembers(ctx, d, meta); err != nil {
+		// The calendar exists by now, so record the members that were synced before the error.
+		return append(err, onCallCalendarRead(ctx, d, meta)...)
+	}
+	// Read the members back (as Better Uptime formats them), since the calendar itself doesn't include them.
+	return onCallCalendarRead(ctx, d, meta)
+}
+
+func onCallCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out onCallCalendarHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/on-call-calendars/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	_, members, err := onCallCalendarFetchMembers(ctx, meta, d.Id())
+	if err != nil {
+		return err
+	}
+	return onCallCalendarCopyAttrs(d, &out.Data.Attributes, members)
+}
+
+func onCallCalendarCopyAttrs(d *schema.ResourceData, in *onCallCalendar, members []onCallCalendarMember) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range onCallCalendarRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	var flattened []interface{}
+	for _, m := range members {
+		var overrides []interface{}
+		for _, o := range m.Overrides {
+			overrides = append(overrides, map[string]interface{}{
+				"from": onCallCalendarFormatTime(o.From),
+				"to":   onCallCalendarFormatTime(o.To),
+			})
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"team_member_id": m.TeamMemberID,
+			"position":       m.Position,
+			"weekdays":       m.Weekdays,
+			"from_hour":      m.FromHour,
+			"to_hour":        m.ToHour,
+			"override":       overrides,
+		})
+	}
+	if err := d.Set("member", flattened); err != nil {
+		derr = append(derr, diag.FromErr(err)[0])
+	}
+	return derr
+}
+
+func onCallCalendarUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in onCallCalendar
+	for _, e := range onCallCalendarRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	if err := resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/on-call-calendars/%s", url.PathEscape(d.Id())), &in); err != nil {
+		return err
+	}
+	if d.HasChange("member") {
+		if err := onCallCalendarSyncMembers(ctx, d, meta); err != nil {
+			return append(err, onCallCalendarRead(ctx, d, meta)...)
+		}
+		return onCallCalendarRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func onCallCalendarDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/on-call-calendars/%s", url.PathEscape(d.Id())))
+}
@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// newOnCallCalendarMembersHandler serves /api/v2/on-call-calendars/{id}/members, formatting override times
+// the way Better Uptime does (e.g. "2021-12-24T00:00:00.000Z").
+func newOnCallCalendarMembersHandler(t *testing.T, calendarID string) http.Handler {
+	prefix := fmt.Sprintf("/api/v2/on-call-calendars/%s/members", calendarID)
+	var mu sync.Mutex
+	var ids []string
+	members := make(map[string][]byte)
+	nextID := 1
+	store := func(id string, r *http.Request) []byte {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m onCallCalendarMember
+		if err := json.Unmarshal(body, &m); err != nil {
+			t.Fatal(err)
+		}
+		for i, o := range m.Overrides {
+			from, err := time.Parse(time.RFC3339, o.From)
+			if err != nil {
+				t.Fatal(err)
+			}
+			to, err := time.Parse(time.RFC3339, o.To)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.Overrides[i].From = from.UTC().Format("2006-01-02T15:04:05.000Z")
+			m.Overrides[i].To = to.UTC().Format("2006-01-02T15:04:05.000Z")
+		}
+		if body, err = json.Marshal(m); err != nil {
+			t.Fatal(err)
+		}
+		members[id] = body
+		return []byte(fmt.Sprintf(`{"data":{"id":%q,"attributes":%s}}`, id, body))
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		mu.Lock()
+		defer mu.Unlock()
+		id := strings.TrimPrefix(r.RequestURI, prefix+"/")
+		_, exists := members[id]
+
+		switch {
+		case r.Method == http.MethodGet && r.RequestURI == prefix+"?page=1":
+			var data []string
+			for _, id := range ids {
+				data = append(data, fmt.Sprintf(`{"id":%q,"attributes":%s}`, id, members[id]))
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s],"pagination":{"next":null}}`, strings.Join(data, ","))))
+		case r.Method == http.MethodPost && r.RequestURI == prefix:
+			id := strconv.Itoa(nextID)
+			nextID++
+			ids = append(ids, id)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(store(id, r))
+		case r.Method == http.MethodPatch && exists:
+			_, _ = w.Write(store(id, r))
+		case r.Method == http.MethodDelete && exists:
+			delete(members, id)
+			for i := range ids {
+				if ids[i] == id {
+					ids = append(ids[:i], ids[i+1:]...)
+					break
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
+		}
+	})
+}
+
+func TestResourceOnCallCalendar(t *testing.T) {
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/on-call-calendars":           newResourceHandler(t, "/api/v2/on-call-calendars", "1", nil),
+		"/api/v2/on-call-calendars/1/members": newOnCallCalendarMembersHandler(t, "1"),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name      = "%s"
+					time_zone = "UTC"
+
+					member {
+						team_member_id = 2
+						position       = 0
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_on_call_calendar.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "time_zone", "UTC"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.team_member_id", "2"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.to_hour", "24"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name      = "%s"
+					time_zone = "Europe/Berlin"
+
+					member {
+						team_member_id = 2
+						position       = 0
+						weekdays       = [1, 2, 3, 4, 5]
+						from_hour      = 9
+						to_hour        = 17
+					}
+
+					member {
+						team_member_id = 3
+						position       = 1
+						weekdays       = [0, 6]
+
+						override {
+							from = "2021-12-24T01:00:00+01:00"
+							to   = "2021-12-27T00:00:00Z"
+						}
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_on_call_calendar.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "time_zone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.weekdays.#", "5"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.from_hour", "9"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.to_hour", "17"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.team_member_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.override.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.override.0.from", "2021-12-24T00:00:00Z"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.override.0.to", "2021-12-27T00:00:00Z"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name      = "%s"
+					time_zone = "Europe/Berlin"
+
+					member {
+						team_member_id = 2
+						position       = 0
+						weekdays       = [1, 2, 3, 4, 5]
+						from_hour      = 9
+						to_hour        = 17
+					}
+
+					member {
+						team_member_id = 3
+						position       = 1
+						weekdays       = [0, 6]
+
+						override {
+							from = "2021-12-24T01:00:00+01:00"
+							to   = "2021-12-27T00:00:00Z"
+						}
+					}
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - update (remove a member).
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name      = "%s"
+					time_zone = "Europe/Berlin"
+
+					member {
+						team_member_id = 3
+						position       = 0
+						weekdays       = [0, 6]
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.team_member_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.override.#", "0"),
+				),
+			},
+			// Step 5 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_on_call_calendar" "this" {
+					name      = "%s"
+					time_zone = "Europe/Berlin"
+
+					member {
+						team_member_id = 3
+						position       = 0
+						weekdays       = [0, 6]
+					}
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 6 - destroy.
+			{
+				ResourceName:      "betteruptime_on_call_calendar.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceOnCallCalendarMemberOrder(t *testing.T) {
+	var creates, deletes int
+	var updates []string
+	members := newOnCallCalendarMembersHandler(t, "1")
+	mux := http.NewServeMux()
+	for prefix, h := range map[string]http.Handler{
+		"/api/v2/on-call-calendars": newResourceHandler(t, "/api/v2/on-call-calendars", "1", nil),
+		"/api/v2/on-call-calendars/1/members": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				creates++
+			case http.MethodPatch:
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var m onCallCalendarMember
+				if err := json.Unmarshal(body, &m); err != nil {
+					t.Fatal(err)
+				}
+				updates = append(updates, fmt.Sprintf("%s=%d", path.Base(r.URL.Path), m.TeamMemberID))
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			case http.MethodDelete:
+				deletes++
+			}
+			members.ServeHTTP(w, r)
+		}),
+	} {
+		mux.Handle(prefix, h)
+		mux.Handle(prefix+"/", h)
+	}
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := func(teamMemberIDs ...int) string {
+		var blocks []string
+		for _, id := range teamMemberIDs {
+			blocks = append(blocks, fmt.Sprintf(`
+			member {
+				team_member_id = %d
+			}
+			`, id))
+		}
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_on_call_calendar" "this" {
+			name = "example"
+			%s
+		}
+		`, strings.Join(blocks, ""))
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create, check positions default to the block index.
+			{
+				Config: config(2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.team_member_id", "2"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.position", "0"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.team_member_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.position", "1"),
+				),
+			},
+			// Step 2 - swap the members, check they are updated rather than recreated.
+			{
+				Config: config(3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.team_member_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.position", "0"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.team_member_id", "2"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.1.position", "1"),
+					func(s *terraform.State) error {
+						if creates != 2 || deletes != 0 {
+							return fmt.Errorf("expected members to be updated in place, got %d creates and %d deletes", creates, deletes)
+						}
+						// Members 1 and 2 were created for team members 2 and 3 respectively.
+						if got := strings.Join(updates, ","); got != "2=3,1=2" {
+							return fmt.Errorf("expected members to be matched by team_member_id, got updates %s", got)
+						}
+						return nil
+					},
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config(3, 2),
+				PlanOnly: true,
+			},
+			// Step 4 - remove the first member, check only that member is deleted.
+			{
+				Config: config(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_on_call_calendar.this", "member.0.team_member_id", "2"),
+					func(s *terraform.State) error {
+						if creates != 2 || deletes != 1 {
+							return fmt.Errorf("expected 1 member to be deleted, got %d creates and %d deletes", creates, deletes)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
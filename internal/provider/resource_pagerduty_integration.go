@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var pagerdutyIntegrationSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this PagerDuty Integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		// PagerDuty integrations can't be moved to another team.
+		ForceNew: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Better Uptime may normalize the case of the team name.
+			return strings.EqualFold(old, new)
+		},
+	},
+	"name": {
+		Description: "A name of the PagerDuty integration that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"pagerduty_service_key": {
+		Description: "The integration key of the PagerDuty service (Events API v2) we should send incidents to.",
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+	},
+	"webhook_url": {
+		Description: "The URL you should add as a webhook in PagerDuty so that acknowledgements and resolutions are synced back.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"policy_id": {
+		Description: "The ID of the escalation policy created for this integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newPagerdutyIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pagerdutyIntegrationCreate,
+		ReadContext:   pagerdutyIntegrationRead,
+		UpdateContext: pagerdutyIntegrationUpdate,
+		DeleteContext: pagerdutyIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/pagerduty-integrations-api",
+		Schema:      pagerdutyIntegrationSchema,
+	}
+}
+
+type pagerdutyIntegration struct {
+	TeamName   *string `json:"team_name,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Key        *string `json:"key,omitempty"`
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	PolicyID   *string `json:"policy_id,omitempty"`
+}
+
+type pagerdutyIntegrationHTTPResponse struct {
+	Data struct {
+		ID         string               `json:"id"`
+		Attributes pagerdutyIntegration `json:"attributes"`
+	} `json:"data"`
+}
+
+func pagerdutyIntegrationRef(in *pagerdutyIntegration) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "team_name", v: &in.TeamName},
+		{k: "name", v: &in.Name},
+		{k: "pagerduty_service_key", v: &in.Key},
+		{k: "webhook_url", v: &in.WebhookURL},
+		{k: "policy_id", v: &in.PolicyID},
+	}
+}
+
+func pagerdutyIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in pagerdutyIntegration
+	for _, e := range pagerdutyIntegrationRef(&in) {
+		load(d, e.k, e.v)
+	}
+	in.TeamName = defaultTeamName(meta, in.TeamName)
+	var out pagerdutyIntegrationHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/pager-duty-webhooks", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return pagerdutyIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func pagerdutyIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out pagerdutyIntegrationHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/pager-duty-webhooks/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return pagerdutyIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func pagerdutyIntegrationCopyAttrs(d *schema.ResourceData, in *pagerdutyIntegration) diag.Diagnostics {
+	var derr diag.Diagnostics
+	// Better Uptime doesn't always return the team name, so keep the one we know about.
+	if in.TeamName == nil {
+		if v, ok := d.GetOk("team_name"); ok {
+			t := v.(string)
+			in.TeamName = &t
+		}
+	}
+	for _, e := range pagerdutyIntegrationRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
+
+func pagerdutyIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in pagerdutyIntegration
+	for _, e := range pagerdutyIntegrationRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/pager-duty-webhooks/%s", url.PathEscape(d.Id())), &in)
+}
+
+func pagerdutyIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/pager-duty-webhooks/%s", url.PathEscape(d.Id())))
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourcePagerdutyIntegration(t *testing.T) {
+	server := newComputedResourceServer(t, "/api/v2/pager-duty-webhooks", "1", map[string]interface{}{
+		"webhook_url": "https://betteruptime.com/api/v1/pager-duty-webhook/example",
+		"policy_id":   "2",
+	})
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_pagerduty_integration" "this" {
+					name                  = "%s"
+					pagerduty_service_key = "key1"
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_pagerduty_integration.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "pagerduty_service_key", "key1"),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "webhook_url", "https://betteruptime.com/api/v1/pager-duty-webhook/example"),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "policy_id", "2"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_pagerduty_integration" "this" {
+					name                  = "%s"
+					pagerduty_service_key = "key2"
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_pagerduty_integration.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "pagerduty_service_key", "key2"),
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "webhook_url", "https://betteruptime.com/api/v1/pager-duty-webhook/example"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_pagerduty_integration" "this" {
+					name                  = "%s"
+					pagerduty_service_key = "key2"
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_pagerduty_integration.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourcePagerdutyIntegrationTeamName(t *testing.T) {
+	var teams []string
+	var deletes int
+	handler := newResourceHandler(t, "/api/v2/pager-duty-webhooks", "1", nil)
+	// Better Uptime takes team_name in the request body, but doesn't return it.
+	server := httptest.NewServer(omitAttributes(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var in map[string]interface{}
+			if err := json.Unmarshal(body, &in); err != nil {
+				t.Fatal(err)
+			}
+			teams = append(teams, fmt.Sprint(in["team_name"]))
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		case http.MethodDelete:
+			deletes++
+		}
+		handler.ServeHTTP(w, r)
+	}), "team_name"))
+	defer server.Close()
+
+	config := func(teamName string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_pagerduty_integration" "this" {
+			team_name             = %q
+			name                  = "example"
+			pagerduty_service_key = "key1"
+		}
+		`, teamName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "team_name", "Platform"),
+				),
+			},
+			// Step 2 - change the case only, check plan is empty.
+			{
+				Config:   config("platform"),
+				PlanOnly: true,
+			},
+			// Step 3 - move to another team, check the integration is replaced.
+			{
+				Config: config("Infrastructure"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_pagerduty_integration.this", "team_name", "Infrastructure"),
+					func(s *terraform.State) error {
+						if deletes != 1 || len(teams) != 2 || teams[1] != "Infrastructure" {
+							return fmt.Errorf("expected the integration to be replaced, got %d deletes and creates in %v", deletes, teams)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
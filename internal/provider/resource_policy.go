@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var policyStepTypes = []string{"email", "sms", "call", "push"}
+var policyStepSchema = map[string]*schema.Schema{
+	"type": {
+		Description:  fmt.Sprintf("How should the team members be notified in this step. Valid values: %v.", policyStepTypes),
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(policyStepTypes, false),
+	},
+	"wait_before": {
+		Description: "How long to wait before executing this step. In seconds.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+	"team_member_ids": {
+		Description: "The IDs of the team members to notify in this step.",
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		Optional: true,
+	},
+	"all_team_members": {
+		Description: "Should all team members be notified in this step?",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+}
+
+var policySchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Policy.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "A name of the policy that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"repeat_count": {
+		Description: "How many times should the policy be repeated if the incident isn't acknowledged?",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+	"incident_tone_override": {
+		Description: "The sound to play on mobile devices when an incident is started.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"recovery_tone_override": {
+		Description: "The sound to play on mobile devices when an incident is resolved.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"step": {
+		Description: "A policy step. Steps are executed in the order they are declared.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: policyStepSchema,
+		},
+	},
+}
+
+func newPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: policyCreate,
+		ReadContext:   policyRead,
+		UpdateContext: policyUpdate,
+		DeleteContext: policyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/policies-api",
+		Schema:      policySchema,
+	}
+}
+
+type policyStep struct {
+	Type           string `json:"type"`
+	WaitBefore     int    `json:"wait_before"`
+	TeamMemberIDs  []int  `json:"team_member_ids,omitempty"`
+	AllTeamMembers bool   `json:"all_team_members"`
+}
+
+type policy struct {
+	Name                 *string       `json:"name,omitempty"`
+	RepeatCount          *int          `json:"repeat_count,omitempty"`
+	IncidentToneOverride *string       `json:"incident_tone_override,omitempty"`
+	RecoveryToneOverride *string       `json:"recovery_tone_override,omitempty"`
+	Steps                *[]policyStep `json:"steps,omitempty"`
+}
+
+type policyHTTPResponse struct {
+	Data struct {
+		ID         string `json:"id"`
+		Attributes policy `json:"attributes"`
+	} `json:"data"`
+}
+
+func policyRef(in *policy) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "name", v: &in.Name},
+		{k: "repeat_count", v: &in.RepeatCount},
+		{k: "incident_tone_override", v: &in.IncidentToneOverride},
+		{k: "recovery_tone_override", v: &in.RecoveryToneOverride},
+	}
+}
+
+func policyLoadSteps(d *schema.ResourceData, in *policy) {
+	steps := []policyStep{}
+	for _, v := range d.Get("step").([]interface{}) {
+		m := v.(map[string]interface{})
+		var teamMemberIDs []int
+		for _, id := range m["team_member_ids"].([]interface{}) {
+			teamMemberIDs = append(teamMemberIDs, id.(int))
+		}
+		steps = append(steps, policyStep{
+			Type:           m["type"].(string),
+			WaitBefore:     m["wait_before"].(int),
+			TeamMemberIDs:  teamMemberIDs,
+			AllTeamMembers: m["all_team_members"].(bool),
+		})
+	}
+	in.Steps = &steps
+}
+
+func policyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in policy
+	for _, e := range policyRef(&in) {
+		load(d, e.k, e.v)
+	}
+	policyLoadSteps(d, &in)
+	var out policyHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/policies", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return policyCopyAttrs(d, &out.Data.Attributes)
+}
+
+func policyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out policyHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/policies/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return policyCopyAttrs(d, &out.Data.Attributes)
+}
+
+func policyCopyAttrs(d *schema.ResourceData, in *policy) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range policyRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	var steps []interface{}
+	if in.Steps != nil {
+		for _, s := range *in.Steps {
+			steps = append(steps, map[string]interface{}{
+				"type":             s.Type,
+				"wait_before":      s.WaitBefore,
+				"team_member_ids":  s.TeamMemberIDs,
+				"all_team_members": s.AllTeamMembers,
+			})
+		}
+	}
+	if err := d.Set("step", steps); err != nil {
+		derr = append(derr, diag.FromErr(err)[0])
+	}
+	return derr
+}
+
+func policyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in policy
+	for _, e := range policyRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	if d.HasChange("step") {
+		policyLoadSteps(d, &in)
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/policies/%s", url.PathEscape(d.Id())), &in)
+}
+
+func policyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/policies/%s", url.PathEscape(d.Id())))
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourcePolicy(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/policies", "1")
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_policy" "this" {
+					name         = "%s"
+					repeat_count = 3
+
+					step {
+						type             = "email"
+						all_team_members = true
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_policy.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "repeat_count", "3"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.#", "1"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.0.type", "email"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.0.all_team_members", "true"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_policy" "this" {
+					name                   = "%s"
+					repeat_count           = 5
+					incident_tone_override = "alarm"
+					recovery_tone_override = "chime"
+
+					step {
+						type            = "push"
+						team_member_ids = [2, 3]
+					}
+
+					step {
+						type            = "call"
+						wait_before     = 180
+						team_member_ids = [3]
+					}
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_policy.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "repeat_count", "5"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "incident_tone_override", "alarm"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "recovery_tone_override", "chime"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.0.type", "push"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.0.team_member_ids.#", "2"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.0.all_team_members", "false"),
+					resource.TestCheckResourceAttr("betteruptime_policy.this", "step.1.wait_before", "180"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_policy" "this" {
+					name                   = "%s"
+					repeat_count           = 5
+					incident_tone_override = "alarm"
+					recovery_tone_override = "chime"
+
+					step {
+						type            = "push"
+						team_member_ids = [2, 3]
+					}
+
+					step {
+						type            = "call"
+						wait_before     = 180
+						team_member_ids = [3]
+					}
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var statusPageSchema = map[string]*schema.Schema{
@@ -57,6 +59,17 @@ var statusPageSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "If you don't want to display short incidents on your status page, this attribute is for you.",
 	},
+	"history": {
+		Description: "How many days of history should we display on your status page?",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	},
+	"aggregate_state": {
+		Description: "The overall status of this status page (e.g. operational, degraded or downtime).",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"subscribable": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -69,14 +82,23 @@ var statusPageSchema = map[string]*schema.Schema{
 		Description: "Hide your status page from search engines.",
 	},
 	"custom_css": {
-		Description: "Unleash your inner designer and tweak our status page design to fit your branding.",
+		Description: "Unleash your inner designer and tweak our status page design to fit your branding. Line endings, trailing whitespace and leading/trailing blank lines are normalized.",
 		Type:        schema.TypeString,
 		Optional:    true,
+		StateFunc:   statusPageNormalizeCSS,
 	},
-	"google_analytics_id": {
-		Description: "Specify your own Google Analytics ID if you want to receive hits on your status page.",
+	"custom_javascript": {
+		Description: "Custom JavaScript to inject into your status page (e.g. for analytics or a chat widget). It is executed in your visitors' browsers, so only include code you trust.",
 		Type:        schema.TypeString,
 		Optional:    true,
+		Sensitive:   true,
+	},
+	"google_analytics_id": {
+		Description:      "Specify your own Google Analytics ID if you want to receive hits on your status page. Either a Universal Analytics (`UA-12345678-1`) or a GA4 (`G-XXXXXXXXXX`) ID.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringMatch(statusPageGoogleAnalyticsIDRegexp, "must be a UA-NNNNNNNN-N or G-XXXXXXXXXX Google Analytics ID"),
+		DiffSuppressFunc: statusPageGoogleAnalyticsIDDiffSuppress,
 	},
 	"announcement": {
 		Description: "Add an announcement to your status page.",
@@ -86,12 +108,13 @@ var statusPageSchema = map[string]*schema.Schema{
 	"announcement_embed_visible": {
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Description: strings.ReplaceAll(`Toggle this field if you want to show an announcement in your embed. You can embed the announcement using this snippet: **<script src="https://betteruptime.com/widgets/announcement.js" data-id="<SET STATUS_PAGE_ID>" async="async" type="text/javascript"></script>**`, "**", "`"),
+		Description: strings.ReplaceAll(`Toggle this field if you want to show an announcement in your embed. You can embed the announcement using this snippet: **<script src="https://betteruptime.com/widgets/announcement.js" data-id="<SET STATUS_PAGE_ID>" async="async" type="text/javascript"></script>**. Requires **announcement**.`, "**", "`"),
 	},
 	"announcement_embed_link": {
-		Description: "Point your embedded announcement to a specified URL.",
-		Type:        schema.TypeString,
-		Optional:    true,
+		Description:  "Point your embedded announcement to a specified URL. Requires `announcement_embed_visible = true`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 	},
 	"announcement_embed_custom_css": {
 		Description: "Modify the design of the announcement embed.",
@@ -104,12 +127,31 @@ var statusPageSchema = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	"password": {
-		Description: "Set a password of your status page (we won't store it as plaintext, promise). Required when password_enabled: true. We will set password_enabled: false automatically when you send us an empty password.",
+		Description: "Set a password of your status page (we won't store it as plaintext, promise). Required when password_enabled: true. We will set password_enabled: false automatically when you send us an empty password. Better Uptime doesn't return the password, so changes made outside of Terraform aren't detected.",
 		Type:        schema.TypeString,
 		Optional:    true,
+		Sensitive:   true,
 	},
 }
 
+var statusPageGoogleAnalyticsIDRegexp = regexp.MustCompile(`^(?i)(UA-[0-9]{4,10}-[0-9]{1,4}|G-[A-Z0-9]{4,})$`)
+
+// statusPageGoogleAnalyticsIDDiffSuppress ignores case, as Google Analytics IDs are case-insensitive.
+func statusPageGoogleAnalyticsIDDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// statusPageNormalizeCSS normalizes line endings and strips trailing whitespace from every line
+// (and blank lines around the stylesheet), so that reformatting a heredoc doesn't cause a diff.
+// Whitespace within a line is left alone as it may be significant (e.g. in `content: "  "`).
+func statusPageNormalizeCSS(v interface{}) string {
+	lines := strings.Split(strings.ReplaceAll(v.(string), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
 func newStatusPageResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: statusPageCreate,
@@ -119,11 +161,28 @@ func newStatusPageResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: "https://docs.betteruptime.com/api/status-pages-api",
-		Schema:      statusPageSchema,
+		CustomizeDiff: statusPageCustomizeDiff,
+		Description:   "https://docs.betteruptime.com/api/status-pages-api",
+		Schema:        statusPageSchema,
 	}
 }
 
+// statusPageCustomizeDiff checks that the announcement attributes are consistent: the embed can only show
+// an announcement that is set, and a link only makes sense for a visible embed.
+func statusPageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("announcement") || !d.NewValueKnown("announcement_embed_visible") || !d.NewValueKnown("announcement_embed_link") {
+		return nil
+	}
+	visible := d.Get("announcement_embed_visible").(bool)
+	if visible && d.Get("announcement").(string) == "" {
+		return fmt.Errorf("announcement must be set when announcement_embed_visible is true")
+	}
+	if !visible && d.Get("announcement_embed_link").(string) != "" {
+		return fmt.Errorf("announcement_embed_visible must be true when announcement_embed_link is set")
+	}
+	return nil
+}
+
 type statusPage struct {
 	CompanyName              *string `json:"company_name,omitempty"`
 	CompanyURL               *string `json:"company_url,omitempty"`
@@ -133,9 +192,12 @@ type statusPage struct {
 	Subdomain                *string `json:"subdomain,omitempty"`
 	CustomDomain             *string `json:"custom_domain,omitempty"`
 	MinIncidentLength        *int    `json:"min_incident_length,omitempty"`
+	History                  *int    `json:"history,omitempty"`
+	AggregateState           *string `json:"aggregate_state,omitempty"`
 	Subscribable             *bool   `json:"subscribable,omitempty"`
 	HideFromSearchEngines    *bool   `json:"hide_from_search_engines,omitempty"`
 	CustomCSS                *string `json:"custom_css,omitempty"`
+	CustomJavaScript         *string `json:"custom_javascript,omitempty"`
 	GoogleAnalyticsID        *string `json:"google_analytics_id,omitempty"`
 	Announcement             *string `json:"announcement,omitempty"`
 	AnnouncementEmbedVisible *bool   `json:"announcement_embed_visible,omitempty"`
@@ -169,9 +231,12 @@ func statusPageRef(in *statusPage) []struct {
 		{k: "subdomain", v: &in.Subdomain},
 		{k: "custom_domain", v: &in.CustomDomain},
 		{k: "min_incident_length", v: &in.MinIncidentLength},
+		{k: "history", v: &in.History},
+		{k: "aggregate_state", v: &in.AggregateState},
 		{k: "subscribable", v: &in.Subscribable},
 		{k: "hide_from_search_engines", v: &in.HideFromSearchEngines},
 		{k: "custom_css", v: &in.CustomCSS},
+		{k: "custom_javascript", v: &in.CustomJavaScript},
 		{k: "google_analytics_id", v: &in.GoogleAnalyticsID},
 		{k: "announcement", v: &in.Announcement},
 		{k: "announcement_embed_visible", v: &in.AnnouncementEmbedVisible},
@@ -207,7 +272,15 @@ func statusPageRead(ctx context.Context, d *schema.ResourceData, meta interface{
 
 func statusPageCopyAttrs(d *schema.ResourceData, in *statusPage) diag.Diagnostics {
 	var derr diag.Diagnostics
+	if in.CustomCSS != nil {
+		css := statusPageNormalizeCSS(*in.CustomCSS)
+		in.CustomCSS = &css
+	}
 	for _, e := range statusPageRef(in) {
+		if e.k == "password" && in.Password == nil {
+			// Write-only: keep the password from the prior state instead of clearing it.
+			continue
+		}
 		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
 			derr = append(derr, diag.FromErr(err)[0])
 		}
@@ -23,6 +23,12 @@ var statusPageResourceSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Required:    true,
 	},
+	"status_page_section_id": {
+		Description: "The ID of the Status Page Section. If you don't specify a section, we add the resource to the first section.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+	},
 	"resource_id": {
 		Description: "The ID of the resource you are adding.",
 		Type:        schema.TypeInt,
@@ -86,12 +92,13 @@ func newStatusPageResourceResource() *schema.Resource {
 }
 
 type statusPageResource struct {
-	ResourceID   *int    `json:"resource_id,omitempty"`
-	ResourceType *string `json:"resource_type,omitempty"`
-	PublicName   *string `json:"public_name,omitempty"`
-	Explanation  *string `json:"explanation,omitempty"`
-	History      *bool   `json:"history,omitempty"`
-	Position     *int    `json:"position,omitempty"`
+	StatusPageSectionID *int    `json:"status_page_section_id,omitempty"`
+	ResourceID          *int    `json:"resource_id,omitempty"`
+	ResourceType        *string `json:"resource_type,omitempty"`
+	PublicName          *string `json:"public_name,omitempty"`
+	Explanation         *string `json:"explanation,omitempty"`
+	History             *bool   `json:"history,omitempty"`
+	Position            *int    `json:"position,omitempty"`
 }
 
 type statusPageResourceHTTPResponse struct {
@@ -110,6 +117,7 @@ func statusPageResourceRef(in *statusPageResource) []struct {
 		k string
 		v interface{}
 	}{
+		{k: "status_page_section_id", v: &in.StatusPageSectionID},
 		{k: "resource_id", v: &in.ResourceID},
 		{k: "resource_type", v: &in.ResourceType},
 		{k: "public_name", v: &in.PublicName},
@@ -54,16 +54,18 @@ func TestResourceStatusPageResource(t *testing.T) {
 				}
 
 				resource "betteruptime_status_page_resource" "this" {
-					status_page_id = "0"
-					resource_id    = "3"
-					resource_type  = "Monitor"
-					public_name    = "%s"
+					status_page_id         = "0"
+					status_page_section_id = 4
+					resource_id            = "3"
+					resource_type          = "Monitor"
+					public_name            = "%s"
 				}
 				`, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("betteruptime_status_page_resource.this", "id"),
 					resource.TestCheckResourceAttr("betteruptime_status_page_resource.this", "public_name", name),
 					resource.TestCheckResourceAttr("betteruptime_status_page_resource.this", "resource_id", "3"),
+					resource.TestCheckResourceAttr("betteruptime_status_page_resource.this", "status_page_section_id", "4"),
 				),
 				PreConfig: func() {
 					t.Log("step 2")
@@ -77,10 +79,11 @@ func TestResourceStatusPageResource(t *testing.T) {
 				}
 
 				resource "betteruptime_status_page_resource" "this" {
-					status_page_id = "0"
-					resource_id    = "3"
-					resource_type  = "Monitor"
-					public_name    = "%s"
+					status_page_id         = "0"
+					status_page_section_id = 4
+					resource_id            = "3"
+					resource_type          = "Monitor"
+					public_name            = "%s"
 				}
 				`, name),
 				PlanOnly: true,
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var statusPageSubscriptionSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Status Page Subscription.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"status_page_id": {
+		Description: "The ID of the Status Page. Changing it creates a new subscription.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"email": {
+		Description: "The email address that receives status page updates. Changing it creates a new subscription.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"created_at": {
+		Description: "The time when this subscription was created.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+}
+
+func newStatusPageSubscriptionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: statusPageSubscriptionCreate,
+		ReadContext:   statusPageSubscriptionRead,
+		DeleteContext: statusPageSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				split := strings.SplitN(d.Id(), "/", 2)
+				if len(split) != 2 {
+					return nil, errors.New("betteruptime_status_page_subscription can be imported via \"status_page_id/id\" only (e.g. \"0/1\")")
+				}
+				if err := d.Set("status_page_id", split[0]); err != nil {
+					return nil, err
+				}
+				d.SetId(split[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "https://docs.betteruptime.com/api/status-page-subscribers-api",
+		Schema:      statusPageSubscriptionSchema,
+	}
+}
+
+type statusPageSubscription struct {
+	Email     *string `json:"email,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+}
+
+type statusPageSubscriptionHTTPResponse struct {
+	Data struct {
+		ID         string                 `json:"id"`
+		Attributes statusPageSubscription `json:"attributes"`
+	} `json:"data"`
+}
+
+func statusPageSubscriptionRef(in *statusPageSubscription) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "email", v: &in.Email},
+		{k: "created_at", v: &in.CreatedAt},
+	}
+}
+
+func statusPageSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in statusPageSubscription
+	for _, e := range statusPageSubscriptionRef(&in) {
+		load(d, e.k, e.v)
+	}
+	statusPageID := d.Get("status_page_id").(string)
+	var out statusPageSubscriptionHTTPResponse
+	if err := resourceCreate(ctx, meta, fmt.Sprintf("/api/v2/status-pages/%s/subscribers", url.PathEscape(statusPageID)), &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return statusPageSubscriptionCopyAttrs(d, &out.Data.Attributes)
+}
+
+func statusPageSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	statusPageID := d.Get("status_page_id").(string)
+	var out statusPageSubscriptionHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/status-pages/%s/subscribers/%s", url.PathEscape(statusPageID), url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return statusPageSubscriptionCopyAttrs(d, &out.Data.Attributes)
+}
+
+func statusPageSubscriptionCopyAttrs(d *schema.ResourceData, in *statusPageSubscription) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range statusPageSubscriptionRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
+
+func statusPageSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	statusPageID := d.Get("status_page_id").(string)
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/status-pages/%s/subscribers/%s", url.PathEscape(statusPageID), url.PathEscape(d.Id())))
+}
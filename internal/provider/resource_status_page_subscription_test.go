@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceStatusPageSubscription(t *testing.T) {
+	server := newComputedResourceServer(t, "/api/v2/status-pages/0/subscribers", "1", map[string]interface{}{
+		"created_at": "2021-01-01T00:00:00.000Z",
+	})
+	defer server.Close()
+
+	var email = "jane@example.com"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_status_page_subscription" "this" {
+					status_page_id = "0"
+					email          = "%s"
+				}
+				`, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_status_page_subscription.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_status_page_subscription.this", "email", email),
+					resource.TestCheckResourceAttr("betteruptime_status_page_subscription.this", "created_at", "2021-01-01T00:00:00.000Z"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_status_page_subscription" "this" {
+					status_page_id = "0"
+					email          = "%s"
+				}
+				`, email),
+				PlanOnly: true,
+			},
+			// Step 3 - destroy.
+			{
+				ResourceName:      "betteruptime_status_page_subscription.this",
+				ImportState:       true,
+				ImportStateId:     "0/1",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,7 +12,9 @@ import (
 )
 
 func TestResourceStatusPage(t *testing.T) {
-	server := newResourceServer(t, "/api/v2/status-pages", "1")
+	server := newComputedResourceServer(t, "/api/v2/status-pages", "1", map[string]interface{}{
+		"aggregate_state": "operational",
+	})
 	defer server.Close()
 
 	var subdomain = "example"
@@ -41,6 +45,7 @@ func TestResourceStatusPage(t *testing.T) {
 					resource.TestCheckResourceAttrSet("betteruptime_status_page.this", "id"),
 					resource.TestCheckResourceAttr("betteruptime_status_page.this", "subdomain", subdomain),
 					resource.TestCheckResourceAttr("betteruptime_status_page.this", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "aggregate_state", "operational"),
 				),
 			},
 			// Step 2 - update.
@@ -55,12 +60,18 @@ func TestResourceStatusPage(t *testing.T) {
 				    company_url  = "https://example.com"
 				    timezone     = "America/Los_Angeles"
 				    subdomain    = "%s"
+				    history      = 30
+
+				    custom_javascript = "window.dataLayer = window.dataLayer || [];"
 				}
 				`, subdomain),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("betteruptime_status_page.this", "id"),
 					resource.TestCheckResourceAttr("betteruptime_status_page.this", "subdomain", subdomain),
 					resource.TestCheckResourceAttr("betteruptime_status_page.this", "timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "history", "30"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "custom_javascript", "window.dataLayer = window.dataLayer || [];"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "aggregate_state", "operational"),
 				),
 			},
 			// Step 3 - make no changes, check plan is empty.
@@ -75,6 +86,9 @@ func TestResourceStatusPage(t *testing.T) {
 				    company_url  = "https://example.com"
 				    timezone     = "America/Los_Angeles"
 				    subdomain    = "%s"
+				    history      = 30
+
+				    custom_javascript = "window.dataLayer = window.dataLayer || [];"
 				}
 				`, subdomain),
 				PlanOnly: true,
@@ -88,3 +102,276 @@ func TestResourceStatusPage(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceStatusPagePassword(t *testing.T) {
+	// Better Uptime never returns the password.
+	server := httptest.NewServer(omitAttributes(t, newResourceHandler(t, "/api/v2/status-pages", "1", nil), "password"))
+	defer server.Close()
+
+	config := func(password string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_status_page" "this" {
+		    company_name     = "Example, Inc"
+		    company_url      = "https://example.com"
+		    timezone         = "UTC"
+		    subdomain        = "example"
+		    password_enabled = true
+		    password         = "%s"
+		}
+		`, password)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "password_enabled", "true"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "password", "secret"),
+				),
+			},
+			// Step 2 - make no changes, check plan is empty.
+			{
+				Config:   config("secret"),
+				PlanOnly: true,
+			},
+			// Step 3 - update.
+			{
+				Config: config("another secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "password", "another secret"),
+				),
+			},
+			// Step 4 - make no changes, check plan is empty.
+			{
+				Config:   config("another secret"),
+				PlanOnly: true,
+			},
+			// Step 5 - destroy.
+			{
+				ResourceName:            "betteruptime_status_page.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestResourceStatusPageCustomCSS(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/status-pages", "1")
+	defer server.Close()
+
+	config := func(css string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_status_page" "this" {
+		    company_name = "Example, Inc"
+		    company_url  = "https://example.com"
+		    timezone     = "UTC"
+		    subdomain    = "example"
+		    custom_css   = <<-EOT
+%s
+		    EOT
+		}
+		`, css)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config(`
+		    .header {
+		      background: #1e293b;   
+		    }
+
+		    .footer a {
+		      color: #f8fafc;
+		    }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "custom_css", ".header {\n  background: #1e293b;\n}\n\n.footer a {\n  color: #f8fafc;\n}"),
+				),
+			},
+			// Step 2 - make no changes (other than whitespace), check plan is empty.
+			{
+				Config: config(`
+		    .header {
+		      background: #1e293b;
+		    }
+		    
+		    .footer a {
+		      color: #f8fafc;	
+		    }
+
+
+`),
+				PlanOnly: true,
+			},
+			// Step 3 - destroy.
+			{
+				ResourceName:      "betteruptime_status_page.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceStatusPageGoogleAnalyticsID(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/status-pages", "1")
+	defer server.Close()
+
+	config := func(id string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_status_page" "this" {
+		    company_name        = "Example, Inc"
+		    company_url         = "https://example.com"
+		    timezone            = "UTC"
+		    subdomain           = "example"
+		    google_analytics_id = "%s"
+		}
+		`, id)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject a Google Tag Manager ID.
+			{
+				Config:      config("GTM-ABC1234"),
+				ExpectError: regexp.MustCompile(`must be a UA-NNNNNNNN-N or G-XXXXXXXXXX Google Analytics ID`),
+			},
+			// Step 2 - create.
+			{
+				Config: config("G-ABC123XYZ9"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "google_analytics_id", "G-ABC123XYZ9"),
+				),
+			},
+			// Step 3 - change only the case, check plan is empty.
+			{
+				Config:   config("g-abc123xyz9"),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_status_page.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceStatusPageAnnouncement(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/status-pages", "1")
+	defer server.Close()
+
+	config := func(announcement string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_status_page" "this" {
+		    company_name = "Example, Inc"
+		    company_url  = "https://example.com"
+		    timezone     = "UTC"
+		    subdomain    = "example"
+		    %s
+		}
+		`, announcement)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - reject an embed without an announcement.
+			{
+				Config:      config(`announcement_embed_visible = true`),
+				ExpectError: regexp.MustCompile(`announcement must be set when announcement_embed_visible is true`),
+			},
+			// Step 2 - reject a link for a hidden embed.
+			{
+				Config: config(`
+					announcement            = "Scheduled maintenance on Sunday"
+					announcement_embed_link = "https://example.com/maintenance"
+				`),
+				ExpectError: regexp.MustCompile(`announcement_embed_visible must be true when announcement_embed_link is set`),
+			},
+			// Step 3 - reject an invalid link.
+			{
+				Config: config(`
+					announcement               = "Scheduled maintenance on Sunday"
+					announcement_embed_visible = true
+					announcement_embed_link    = "example.com/maintenance"
+				`),
+				ExpectError: regexp.MustCompile(`expected "announcement_embed_link" to have a host`),
+			},
+			// Step 4 - create.
+			{
+				Config: config(`
+					announcement               = "Scheduled maintenance on Sunday"
+					announcement_embed_visible = true
+					announcement_embed_link    = "https://example.com/maintenance"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "announcement", "Scheduled maintenance on Sunday"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "announcement_embed_visible", "true"),
+					resource.TestCheckResourceAttr("betteruptime_status_page.this", "announcement_embed_link", "https://example.com/maintenance"),
+				),
+			},
+			// Step 5 - make no changes, check plan is empty.
+			{
+				Config: config(`
+					announcement               = "Scheduled maintenance on Sunday"
+					announcement_embed_visible = true
+					announcement_embed_link    = "https://example.com/maintenance"
+				`),
+				PlanOnly: true,
+			},
+			// Step 6 - destroy.
+			{
+				ResourceName:      "betteruptime_status_page.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
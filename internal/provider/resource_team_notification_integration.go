@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var teamNotificationIntegrationTypes = []string{"slack", "msteams", "webhook", "telegram", "opsgenie", "zapier"}
+var teamNotificationIntegrationSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The ID of this Team Notification Integration.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		// Notification integrations can't be moved to another team.
+		ForceNew: true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			// Better Uptime may normalize the case of the team name.
+			return strings.EqualFold(old, new)
+		},
+	},
+	"type": {
+		Description:  fmt.Sprintf("The kind of notification integration. Valid values: %v. Changing it creates a new integration.", teamNotificationIntegrationTypes),
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(teamNotificationIntegrationTypes, false),
+	},
+	"name": {
+		Description: "A name of the notification integration that you can see in the dashboard.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"webhook_url": {
+		Description: "The URL we should send notifications to (e.g. a Slack or Microsoft Teams incoming webhook, or a Zapier webhook). For `type = \"msteams\"` this is the incoming webhook URL of the Teams channel.",
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+	},
+	"paused": {
+		Description: "Set to true to pause the integration - we won't send notifications to it. Set to false to resume.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
+}
+
+func newTeamNotificationIntegrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: teamNotificationIntegrationCreate,
+		ReadContext:   teamNotificationIntegrationRead,
+		UpdateContext: teamNotificationIntegrationUpdate,
+		DeleteContext: teamNotificationIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://docs.betteruptime.com/api/notification-integrations-api",
+		Schema:      teamNotificationIntegrationSchema,
+	}
+}
+
+type teamNotificationIntegration struct {
+	TeamName   *string `json:"team_name,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	Paused     *bool   `json:"paused,omitempty"`
+}
+
+type teamNotificationIntegrationHTTPResponse struct {
+	Data struct {
+		ID         string                      `json:"id"`
+		Attributes teamNotificationIntegration `json:"attributes"`
+	} `json:"data"`
+}
+
+func teamNotificationIntegrationRef(in *teamNotificationIntegration) []struct {
+	k string
+	v interface{}
+} {
+	// TODO:  if reflect.TypeOf(in).NumField() != len([]struct)
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "team_name", v: &in.TeamName},
+		{k: "type", v: &in.Type},
+		{k: "name", v: &in.Name},
+		{k: "webhook_url", v: &in.WebhookURL},
+		{k: "paused", v: &in.Paused},
+	}
+}
+
+func teamNotificationIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in teamNotificationIntegration
+	for _, e := range teamNotificationIntegrationRef(&in) {
+		load(d, e.k, e.v)
+	}
+	in.TeamName = defaultTeamName(meta, in.TeamName)
+	var out teamNotificationIntegrationHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/notification-integrations", &in, &out); err != nil {
+		return err
+	}
+	d.SetId(out.Data.ID)
+	return teamNotificationIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func teamNotificationIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out teamNotificationIntegrationHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/notification-integrations/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("") // Force "create" on 404.
+		return nil
+	}
+	return teamNotificationIntegrationCopyAttrs(d, &out.Data.Attributes)
+}
+
+func teamNotificationIntegrationCopyAttrs(d *schema.ResourceData, in *teamNotificationIntegration) diag.Diagnostics {
+	var derr diag.Diagnostics
+	// Better Uptime doesn't always return the team name, so keep the one we know about.
+	if in.TeamName == nil {
+		if v, ok := d.GetOk("team_name"); ok {
+			t := v.(string)
+			in.TeamName = &t
+		}
+	}
+	for _, e := range teamNotificationIntegrationRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+	return derr
+}
+
+func teamNotificationIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in teamNotificationIntegration
+	for _, e := range teamNotificationIntegrationRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/notification-integrations/%s", url.PathEscape(d.Id())), &in)
+}
+
+func teamNotificationIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/notification-integrations/%s", url.PathEscape(d.Id())))
+}
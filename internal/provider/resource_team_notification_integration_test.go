@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceTeamNotificationIntegration(t *testing.T) {
+	server := newResourceServer(t, "/api/v2/notification-integrations", "1")
+	defer server.Close()
+
+	var name = "example"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_team_notification_integration" "this" {
+					team_name   = "Platform"
+					type        = "slack"
+					name        = "%s"
+					webhook_url = "https://hooks.slack.com/services/T0/B0/one"
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_team_notification_integration.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "team_name", "Platform"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "type", "slack"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "name", name),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "webhook_url", "https://hooks.slack.com/services/T0/B0/one"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "paused", "false"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_team_notification_integration" "this" {
+					team_name   = "Platform"
+					type        = "slack"
+					name        = "%s"
+					webhook_url = "https://hooks.slack.com/services/T0/B0/two"
+					paused      = true
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("betteruptime_team_notification_integration.this", "id"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "webhook_url", "https://hooks.slack.com/services/T0/B0/two"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "paused", "true"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config: fmt.Sprintf(`
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_team_notification_integration" "this" {
+					team_name   = "Platform"
+					type        = "slack"
+					name        = "%s"
+					webhook_url = "https://hooks.slack.com/services/T0/B0/two"
+					paused      = true
+				}
+				`, name),
+				PlanOnly: true,
+			},
+			// Step 4 - destroy.
+			{
+				ResourceName:      "betteruptime_team_notification_integration.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceTeamNotificationIntegrationMSTeams(t *testing.T) {
+	var deletes int32
+	handler := newResourceHandler(t, "/api/v2/notification-integrations", "1", nil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			atomic.AddInt32(&deletes, 1)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	config := func(webhookURL string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_team_notification_integration" "this" {
+			type        = "msteams"
+			name        = "Incidents"
+			webhook_url = "%s"
+		}
+		`, webhookURL)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if n := atomic.LoadInt32(&deletes); n != 1 {
+				return fmt.Errorf("expected 1 DELETE request, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("https://example.webhook.office.com/webhookb2/one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "type", "msteams"),
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "webhook_url", "https://example.webhook.office.com/webhookb2/one"),
+				),
+			},
+			// Step 2 - update.
+			{
+				Config: config("https://example.webhook.office.com/webhookb2/two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "webhook_url", "https://example.webhook.office.com/webhookb2/two"),
+				),
+			},
+			// Step 3 - make no changes, check plan is empty.
+			{
+				Config:   config("https://example.webhook.office.com/webhookb2/two"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourceTeamNotificationIntegrationTeamName(t *testing.T) {
+	var teams []string
+	var deletes int
+	handler := newResourceHandler(t, "/api/v2/notification-integrations", "1", nil)
+	// Better Uptime takes team_name in the request body, but doesn't return it.
+	server := httptest.NewServer(omitAttributes(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var in map[string]interface{}
+			if err := json.Unmarshal(body, &in); err != nil {
+				t.Fatal(err)
+			}
+			teams = append(teams, fmt.Sprint(in["team_name"]))
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		case http.MethodDelete:
+			deletes++
+		}
+		handler.ServeHTTP(w, r)
+	}), "team_name"))
+	defer server.Close()
+
+	config := func(teamName string) string {
+		return fmt.Sprintf(`
+		provider "betteruptime" {
+			api_token = "foo"
+		}
+
+		resource "betteruptime_team_notification_integration" "this" {
+			team_name   = %q
+			type        = "slack"
+			name        = "example"
+			webhook_url = "https://hooks.slack.com/services/T0/B0/one"
+		}
+		`, teamName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			// Step 1 - create.
+			{
+				Config: config("Platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "team_name", "Platform"),
+				),
+			},
+			// Step 2 - change the case only, check plan is empty.
+			{
+				Config:   config("platform"),
+				PlanOnly: true,
+			},
+			// Step 3 - move to another team, check the integration is replaced.
+			{
+				Config: config("Infrastructure"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("betteruptime_team_notification_integration.this", "team_name", "Infrastructure"),
+					func(s *terraform.State) error {
+						if deletes != 1 || len(teams) != 2 || teams[1] != "Infrastructure" {
+							return fmt.Errorf("expected the integration to be replaced, got %d deletes and creates in %v", deletes, teams)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTeamNotificationIntegrationType(t *testing.T) {
+	validate := New().ResourcesMap["betteruptime_team_notification_integration"].Schema["type"].ValidateFunc
+	for _, tc := range []struct {
+		typ   string
+		valid bool
+	}{
+		{"slack", true},
+		{"msteams", true},
+		{"webhook", true},
+		{"telegram", true},
+		{"opsgenie", true},
+		{"zapier", true},
+		{"Slack", false},
+		{"pagerduty", false},
+		{"", false},
+	} {
+		if _, errs := validate(tc.typ, "type"); (len(errs) == 0) != tc.valid {
+			t.Errorf("type %q: got errors %v, want valid=%v", tc.typ, errs, tc.valid)
+		}
+	}
+}
@@ -6,13 +6,30 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"sync/atomic"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func newResourceServer(t *testing.T, baseRequestURI, id string) *httptest.Server {
+	return newComputedResourceServer(t, baseRequestURI, id, nil)
+}
+
+// newComputedResourceServer is like newResourceServer but also injects computed
+// attributes into the resource on create (mimicking values assigned by Better Uptime).
+func newComputedResourceServer(t *testing.T, baseRequestURI, id string, computed map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(newResourceHandler(t, baseRequestURI, id, computed))
+}
+
+// newResourceHandler returns the handler behind newComputedResourceServer, so that
+// several resources can be served by the same server (e.g. using http.ServeMux).
+func newResourceHandler(t *testing.T, baseRequestURI, id string, computed map[string]interface{}) http.Handler {
 	var data atomic.Value
-	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Log("Received " + r.Method + " " + r.RequestURI)
 
 		if r.Header.Get("Authorization") != "Bearer foo" {
@@ -25,9 +42,27 @@ func newResourceServer(t *testing.T, baseRequestURI, id string) *httptest.Server
 			if err != nil {
 				t.Fatal(err)
 			}
+			if len(computed) != 0 {
+				attrs := make(map[string]interface{})
+				if err = json.Unmarshal(body, &attrs); err != nil {
+					t.Fatal(err)
+				}
+				for k, v := range computed {
+					attrs[k] = v
+				}
+				if body, err = json.Marshal(attrs); err != nil {
+					t.Fatal(err)
+				}
+			}
 			data.Store(body)
 			w.WriteHeader(http.StatusCreated)
 			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"id":%q,"attributes":%s}}`, id, body)))
+		case r.Method == http.MethodGet && r.RequestURI == baseRequestURI+"?page=1":
+			var items []string
+			if body, ok := data.Load().([]byte); ok && body != nil {
+				items = append(items, fmt.Sprintf(`{"id":%q,"attributes":%s}`, id, body))
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":[%s],"pagination":{"next":null}}`, strings.Join(items, ","))))
 		case r.Method == http.MethodGet && r.RequestURI == baseRequestURI+"/"+id:
 			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"id":%q,"attributes":%s}}`, id, data.Load().([]byte))))
 		case r.Method == http.MethodPatch && r.RequestURI == baseRequestURI+"/"+id:
@@ -54,5 +89,87 @@ func newResourceServer(t *testing.T, baseRequestURI, id string) *httptest.Server
 		default:
 			t.Fatal("Unexpected " + r.Method + " " + r.RequestURI)
 		}
+	})
+}
+
+// omitAttributes wraps a handler (e.g. one returned by newResourceHandler) to drop the given attributes
+// from its responses, mimicking attributes that Better Uptime accepts but never returns.
+func omitAttributes(t *testing.T, handler http.Handler, keys ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		body := rec.Body.Bytes()
+		if len(body) != 0 {
+			var res struct {
+				Data struct {
+					ID         string                 `json:"id"`
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(body, &res); err != nil {
+				t.Fatal(err)
+			}
+			for _, k := range keys {
+				delete(res.Data.Attributes, k)
+			}
+			var err error
+			if body, err = json.Marshal(res); err != nil {
+				t.Fatal(err)
+			}
+		}
+		w.WriteHeader(rec.Code)
+		_, _ = w.Write(body)
+	})
+}
+
+func TestAPIErrorMessages(t *testing.T) {
+	for _, tc := range []struct {
+		body string
+		want []string
+	}{
+		{`{"errors":{"url":["is not valid"]}}`, []string{"url is not valid"}},
+		{`{"errors":{"url":["is not valid","is too long"],"name":["can't be blank"]}}`, []string{"name can't be blank", "url is not valid", "url is too long"}},
+		{`{"errors":{"url":"is not valid"}}`, []string{"url is not valid"}},
+		{`{"errors":["Monitor limit reached"]}`, []string{"Monitor limit reached"}},
+		{`{"errors":"Invalid Team API token"}`, []string{"Invalid Team API token"}},
+		{`{"data":{}}`, nil},
+		{`Internal Server Error`, nil},
+	} {
+		if got := apiErrorMessages([]byte(tc.body)); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("apiErrorMessages(%s) = %q, want %q", tc.body, got, tc.want)
+		}
+	}
+}
+
+func TestResourceAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Log("Received " + r.Method + " " + r.RequestURI)
+
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":{"name":["has already been taken"]}}`))
 	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"betteruptime": func() (*schema.Provider, error) {
+				return New(WithURL(server.URL)), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "betteruptime" {
+					api_token = "foo"
+				}
+
+				resource "betteruptime_monitor_group" "this" {
+					name = "example"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)betteruptime API error: name has already been taken.*POST .*/api/v2/monitor-groups returned 422`),
+			},
+		},
+	})
 }